@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
+	"strconv"
 	"time"
 )
 
@@ -81,6 +83,8 @@ func (s *Scraper) setCSRFToken(req *http.Request) {
 }
 
 func (s *Scraper) handleResponse(resp *http.Response, target interface{}) error {
+	s.saveRateLimit(resp)
+
 	content, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
@@ -99,6 +103,42 @@ func (s *Scraper) handleResponse(resp *http.Response, target interface{}) error
 
 	return json.Unmarshal(content, target)
 }
+
+// rateLimit of endpoint from the last response headers.
+type rateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// saveRateLimit stores rate limit headers of response by last path element, like UserTweets or 44196397.json.
+func (s *Scraper) saveRateLimit(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	if err != nil || resp.Request == nil {
+		return
+	}
+	limit, _ := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Limit"))
+	reset, _ := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Reset"), 10, 64)
+
+	s.rateLimitMutex.Lock()
+	defer s.rateLimitMutex.Unlock()
+	if s.rateLimits == nil {
+		s.rateLimits = make(map[string]rateLimit)
+	}
+	s.rateLimits[path.Base(resp.Request.URL.Path)] = rateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+}
+
+func (s *Scraper) getRateLimit(endpoint string) (rateLimit, bool) {
+	s.rateLimitMutex.Lock()
+	defer s.rateLimitMutex.Unlock()
+	limit, ok := s.rateLimits[endpoint]
+	return limit, ok
+}
+
 func (s *Scraper) Guest() string {
 	return s.guestToken
 }
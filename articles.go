@@ -0,0 +1,121 @@
+package twitterscraper
+
+import (
+	"context"
+	"time"
+)
+
+// ArticleInlineStyle is a styled range of article block text.
+type ArticleInlineStyle struct {
+	Offset int
+	Length int
+	Style  string
+}
+
+// ArticleBlock is a paragraph, header, list item or media placeholder of the article.
+type ArticleBlock struct {
+	Type         string
+	Text         string
+	InlineStyles []ArticleInlineStyle
+}
+
+// Article type.
+type Article struct {
+	ID          string
+	Title       string
+	PreviewText string
+	Text        string
+	Cover       *Photo
+	Blocks      []ArticleBlock
+	PublishedAt time.Time
+}
+
+type article struct {
+	ArticleResults struct {
+		Result struct {
+			RestID      string       `json:"rest_id"`
+			Title       string       `json:"title"`
+			PreviewText string       `json:"preview_text"`
+			PlainText   string       `json:"plain_text"`
+			CoverMedia  MediaWrapper `json:"cover_media"`
+			Metadata    struct {
+				FirstPublishedAtSecs int64 `json:"first_published_at_secs"`
+			} `json:"metadata"`
+			ContentState struct {
+				Blocks []struct {
+					Key               string `json:"key"`
+					Text              string `json:"text"`
+					Type              string `json:"type"`
+					InlineStyleRanges []struct {
+						Offset int    `json:"offset"`
+						Length int    `json:"length"`
+						Style  string `json:"style"`
+					} `json:"inlineStyleRanges"`
+				} `json:"blocks"`
+			} `json:"content_state"`
+		} `json:"result"`
+	} `json:"article_results"`
+}
+
+func (a *article) parse() *Article {
+	result := &a.ArticleResults.Result
+	if result.RestID == "" {
+		return nil
+	}
+
+	article := &Article{
+		ID:          result.RestID,
+		Title:       result.Title,
+		PreviewText: result.PreviewText,
+		Text:        result.PlainText,
+	}
+
+	if result.CoverMedia.MediaInfo.OriginalImgURL != "" {
+		article.Cover = &Photo{
+			URL:    result.CoverMedia.MediaInfo.OriginalImgURL,
+			Width:  result.CoverMedia.MediaInfo.OriginalImgWidth,
+			Height: result.CoverMedia.MediaInfo.OriginalImgHeight,
+		}
+	}
+
+	if result.Metadata.FirstPublishedAtSecs > 0 {
+		article.PublishedAt = time.Unix(result.Metadata.FirstPublishedAtSecs, 0)
+	}
+
+	for _, block := range result.ContentState.Blocks {
+		b := ArticleBlock{
+			Type: block.Type,
+			Text: block.Text,
+		}
+		for _, style := range block.InlineStyleRanges {
+			b.InlineStyles = append(b.InlineStyles, ArticleInlineStyle{
+				Offset: style.Offset,
+				Length: style.Length,
+				Style:  style.Style,
+			})
+		}
+		article.Blocks = append(article.Blocks, b)
+	}
+
+	return article
+}
+
+// GetUserArticles returns channel with article tweets of a given user.
+func (s *Scraper) GetUserArticles(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResultTimeline {
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchUserArticles)
+}
+
+// FetchUserArticles gets article tweets of a given user, via the Twitter frontend API.
+func (s *Scraper) FetchUserArticles(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.FetchUserArticlesByUserID(userID, maxTweetsNbr, cursor)
+}
+
+// FetchUserArticlesByUserID gets article tweets of a given userID, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserArticlesByUserID(userID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.fetchProfileTabTweets("https://x.com/i/api/graphql/N3xRQNdwYvK7Dq8Zcd3N6w/UserArticlesTweets", userID, maxTweetsNbr, cursor)
+}
@@ -0,0 +1,147 @@
+package twitterscraper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// card binding value as returned by both legacy and GraphQL APIs
+type cardBindingValue struct {
+	Type         string `json:"type"`
+	StringValue  string `json:"string_value"`
+	BooleanValue bool   `json:"boolean_value"`
+	ImageValue   struct {
+		URL    string `json:"url"`
+		Width  int    `json:"width"`
+		Height int    `json:"height"`
+		Alt    string `json:"alt"`
+	} `json:"image_value"`
+}
+
+// GraphQL card object
+type card struct {
+	RestID string `json:"rest_id"`
+	Legacy struct {
+		Name          string `json:"name"`
+		URL           string `json:"url"`
+		BindingValues []struct {
+			Key   string           `json:"key"`
+			Value cardBindingValue `json:"value"`
+		} `json:"binding_values"`
+	} `json:"legacy"`
+}
+
+// legacy card object, binding values are keyed by name
+type legacyCard struct {
+	Name          string                      `json:"name"`
+	URL           string                      `json:"url"`
+	BindingValues map[string]cardBindingValue `json:"binding_values"`
+}
+
+func (card *card) bindingValues() map[string]cardBindingValue {
+	values := make(map[string]cardBindingValue, len(card.Legacy.BindingValues))
+	for _, v := range card.Legacy.BindingValues {
+		values[v.Key] = v.Value
+	}
+	return values
+}
+
+// binding keys of card images, ordered from the best quality
+var cardImageKeys = []string{
+	"thumbnail_image_original",
+	"summary_photo_image_original",
+	"player_image_original",
+	"photo_image_full_size_original",
+	"thumbnail_image_large",
+	"summary_photo_image_large",
+	"player_image_large",
+	"photo_image_full_size_large",
+	"thumbnail_image",
+	"summary_photo_image",
+	"player_image",
+	"photo_image_full_size",
+}
+
+func isPollCard(name string) bool {
+	return strings.HasPrefix(name, "poll") && strings.Contains(name, "choice")
+}
+
+func parsePoll(name string, values map[string]cardBindingValue) *Poll {
+	if !isPollCard(name) {
+		return nil
+	}
+
+	poll := &Poll{
+		CountsAreFinal: values["counts_are_final"].BooleanValue,
+	}
+
+	for i := 1; ; i++ {
+		label, ok := values["choice"+strconv.Itoa(i)+"_label"]
+		if !ok {
+			break
+		}
+		count, _ := strconv.Atoi(values["choice"+strconv.Itoa(i)+"_count"].StringValue)
+		poll.Choices = append(poll.Choices, PollChoice{
+			Label: label.StringValue,
+			Count: count,
+		})
+		poll.TotalVotes += count
+	}
+
+	if tm, err := time.Parse(time.RFC3339, values["end_datetime_utc"].StringValue); err == nil {
+		poll.EndTime = tm
+	}
+	if tm, err := time.Parse(time.RFC3339, values["last_updated_datetime_utc"].StringValue); err == nil {
+		poll.LastUpdated = tm
+	}
+	poll.DurationMinutes, _ = strconv.Atoi(values["duration_minutes"].StringValue)
+
+	return poll
+}
+
+func parseCard(name string, url string, values map[string]cardBindingValue, urls []Url) *Card {
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		name = name[i+1:]
+	}
+	if name != "summary" && name != "summary_large_image" && name != "player" {
+		return nil
+	}
+
+	card := &Card{
+		Type:        name,
+		Title:       values["title"].StringValue,
+		Description: values["description"].StringValue,
+		Domain:      values["domain"].StringValue,
+		URL:         values["card_url"].StringValue,
+		PlayerURL:   values["player_url"].StringValue,
+	}
+	if card.Domain == "" {
+		card.Domain = values["vanity_url"].StringValue
+	}
+	if card.URL == "" {
+		card.URL = url
+	}
+	for _, u := range urls {
+		if u.URL == card.URL {
+			card.URL = u.ExpandedURL
+			break
+		}
+	}
+	card.PlayerWidth, _ = strconv.Atoi(values["player_width"].StringValue)
+	card.PlayerHeight, _ = strconv.Atoi(values["player_height"].StringValue)
+
+	for _, key := range cardImageKeys {
+		if image, ok := values[key]; ok && image.ImageValue.URL != "" {
+			card.Image = &CardImage{
+				URL:    image.ImageValue.URL,
+				Width:  image.ImageValue.Width,
+				Height: image.ImageValue.Height,
+				Alt:    image.ImageValue.Alt,
+			}
+			break
+		}
+	}
+
+	return card
+}
@@ -0,0 +1,164 @@
+package twitterscraper
+
+import (
+	"errors"
+	"net/url"
+	"time"
+)
+
+// CommunityNoteEntity is a link within the community note text.
+type CommunityNoteEntity struct {
+	FromIndex int
+	ToIndex   int
+	URL       string
+}
+
+// CommunityNote type.
+type CommunityNote struct {
+	ID             string
+	Text           string
+	Entities       []CommunityNoteEntity
+	URLs           []string
+	RatingStatus   string // like CurrentlyRatedHelpful
+	Classification string
+	CreatedAt      time.Time
+}
+
+type birdwatchText struct {
+	Text     string `json:"text"`
+	Entities []struct {
+		FromIndex int `json:"fromIndex"`
+		ToIndex   int `json:"toIndex"`
+		Ref       struct {
+			Type    string `json:"type"`
+			URL     string `json:"url"`
+			URLType string `json:"urlType"`
+		} `json:"ref"`
+	} `json:"entities"`
+}
+
+func (text *birdwatchText) parse(note *CommunityNote) {
+	note.Text = text.Text
+	for _, entity := range text.Entities {
+		if entity.Ref.URL == "" {
+			continue
+		}
+		note.Entities = append(note.Entities, CommunityNoteEntity{
+			FromIndex: entity.FromIndex,
+			ToIndex:   entity.ToIndex,
+			URL:       entity.Ref.URL,
+		})
+		if !stringInSlice(entity.Ref.URL, note.URLs) {
+			note.URLs = append(note.URLs, entity.Ref.URL)
+		}
+	}
+}
+
+// community note attached to the tweet, only notes rated helpful are attached
+type birdwatchPivot struct {
+	DestinationURL string `json:"destinationUrl"`
+	Note           struct {
+		RestID       string `json:"rest_id"`
+		RatingStatus string `json:"rating_status"`
+	} `json:"note"`
+	Subtitle    birdwatchText `json:"subtitle"`
+	Title       string        `json:"title"`
+	VisualStyle string        `json:"visualStyle"`
+}
+
+func (pivot *birdwatchPivot) parse() *CommunityNote {
+	if pivot.Note.RestID == "" {
+		return nil
+	}
+	note := &CommunityNote{
+		ID:           pivot.Note.RestID,
+		RatingStatus: "CurrentlyRatedHelpful",
+	}
+	pivot.Subtitle.parse(note)
+	return note
+}
+
+type birdwatchNote struct {
+	RestID string `json:"rest_id"`
+	DataV1 struct {
+		Classification string        `json:"classification"`
+		Summary        birdwatchText `json:"summary"`
+	} `json:"data_v1"`
+	RatingStatus string `json:"rating_status"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+func (n *birdwatchNote) parse() *CommunityNote {
+	note := &CommunityNote{
+		ID:             n.RestID,
+		RatingStatus:   n.RatingStatus,
+		Classification: n.DataV1.Classification,
+	}
+	if n.CreatedAt > 0 {
+		note.CreatedAt = time.Unix(0, n.CreatedAt*int64(time.Millisecond))
+	}
+	n.DataV1.Summary.parse(note)
+	return note
+}
+
+type birdwatchNotes struct {
+	Data struct {
+		TweetResultByRestID struct {
+			Result struct {
+				MisleadingBirdwatchNotes struct {
+					Notes []birdwatchNote `json:"notes"`
+				} `json:"misleading_birdwatch_notes"`
+				NotMisleadingBirdwatchNotes struct {
+					Notes []birdwatchNote `json:"notes"`
+				} `json:"not_misleading_birdwatch_notes"`
+			} `json:"result"`
+		} `json:"tweet_result_by_rest_id"`
+	} `json:"data"`
+}
+
+func (notes *birdwatchNotes) parse() []*CommunityNote {
+	var result []*CommunityNote
+	for _, note := range notes.Data.TweetResultByRestID.Result.MisleadingBirdwatchNotes.Notes {
+		result = append(result, note.parse())
+	}
+	for _, note := range notes.Data.TweetResultByRestID.Result.NotMisleadingBirdwatchNotes.Notes {
+		result = append(result, note.parse())
+	}
+	return result
+}
+
+// GetTweetCommunityNotes returns all community notes proposed for a tweet, including notes that weren't rated helpful yet.
+func (s *Scraper) GetTweetCommunityNotes(tweetID string) ([]*CommunityNote, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("GET", "https://x.com/i/api/graphql/tjT4PMWsLvXs6bhPaxqhnQ/BirdwatchFetchNotes")
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]interface{}{
+		"tweet_id": tweetID,
+	}
+
+	features := map[string]interface{}{
+		"responsive_web_birdwatch_media_notes_enabled":       true,
+		"responsive_web_birdwatch_note_limit_enabled":        true,
+		"responsive_web_graphql_timeline_navigation_enabled": true,
+		"responsive_web_birdwatch_translation_enabled":       false,
+	}
+
+	query := url.Values{}
+	query.Set("variables", mapToJSONString(variables))
+	query.Set("features", mapToJSONString(features))
+	req.URL.RawQuery = query.Encode()
+
+	var notes birdwatchNotes
+	err = s.RequestAPI(req, &notes)
+	if err != nil {
+		return nil, err
+	}
+
+	return notes.parse(), nil
+}
@@ -0,0 +1,51 @@
+package twitterscraper_test
+
+import (
+	"testing"
+)
+
+func TestGetTweetCommunityNotes(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	tweetID := "1695880357339459758"
+
+	notes, err := testScraper.GetTweetCommunityNotes(tweetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, note := range notes {
+		if note.ID == "" {
+			t.Error("Expected note ID is empty")
+		}
+		if note.Text == "" {
+			t.Error("Expected note Text is empty")
+		}
+		if note.RatingStatus == "" {
+			t.Error("Expected note RatingStatus is empty")
+		}
+	}
+
+	tweet, err := testScraper.GetTweet(tweetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tweet.CommunityNote != nil {
+		if tweet.CommunityNote.RatingStatus != "CurrentlyRatedHelpful" {
+			t.Errorf("Expected attached note is rated helpful, got %q", tweet.CommunityNote.RatingStatus)
+		}
+		found := false
+		for _, note := range notes {
+			if note.ID == tweet.CommunityNote.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Community note %s of tweet not found in fetched notes", tweet.CommunityNote.ID)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package twitterscraper
+
+import (
+	"context"
+	"sort"
+)
+
+// ConversationOptions limits the size of fetched conversation, zero values mean no limit.
+type ConversationOptions struct {
+	// MaxDepth is max reply depth below the conversation root, cursors of deeper threads are not followed.
+	MaxDepth int
+	// MaxTweets is max number of tweets in conversation.
+	MaxTweets int
+}
+
+// ConversationNode is a tweet in conversation tree.
+type ConversationNode struct {
+	Tweet        *Tweet
+	Parent       *ConversationNode
+	Children     []*ConversationNode
+	Depth        int
+	IsSelfThread bool
+}
+
+// Conversation is a tree of tweets with replies.
+type Conversation struct {
+	Root *ConversationNode
+	// Nodes by tweet ID.
+	Nodes map[string]*ConversationNode
+	// Orphans are tweets with parent that was not returned by twitter.
+	Orphans []*ConversationNode
+}
+
+// GetConversation fetches conversation of tweet following all reply cursors and returns it as a tree.
+func (s *Scraper) GetConversation(ctx context.Context, id string, opts *ConversationOptions) (*Conversation, error) {
+	var tweets []*Tweet
+	for tweet := range s.GetConversationTweets(ctx, id, opts) {
+		if tweet.Error != nil {
+			return nil, tweet.Error
+		}
+		tw := tweet.Tweet
+		tweets = append(tweets, &tw)
+	}
+	conversation := newConversation(id, tweets)
+	if opts != nil && opts.MaxDepth > 0 {
+		conversation.prune(opts.MaxDepth)
+	}
+	return conversation, nil
+}
+
+// GetConversationTweets returns channel with every tweet of conversation, use it for very large threads.
+func (s *Scraper) GetConversationTweets(ctx context.Context, id string, opts *ConversationOptions) <-chan *TweetResultTimeline {
+	if opts == nil {
+		opts = &ConversationOptions{}
+	}
+	channel := make(chan *TweetResultTimeline)
+	go func() {
+		defer close(channel)
+		var (
+			seenTweets   = make(map[string]bool)
+			seenCursors  = make(map[string]bool)
+			depths       = make(map[string]int)
+			cursors      = []*ThreadCursor{{FocalTweetID: id}}
+			tweetsNbr    int
+			conversation string
+		)
+		for len(cursors) > 0 {
+			var cursor *ThreadCursor
+			cursor, cursors = cursors[0], cursors[1:]
+
+			select {
+			case <-ctx.Done():
+				channel <- &TweetResultTimeline{Error: ctx.Err()}
+				return
+			default:
+			}
+
+			tweets, nextCursors, err := s.GetTweetReplies(id, cursor.Cursor)
+			if err != nil {
+				channel <- &TweetResultTimeline{Error: err}
+				return
+			}
+
+			for _, tweet := range tweets {
+				// Ancestors of focal tweet come first, all tweets share conversation ID of root
+				if conversation == "" && tweet.ConversationID != "" {
+					conversation = tweet.ConversationID
+				}
+				if tweet.ID == conversation {
+					depths[tweet.ID] = 0
+				} else if depth, ok := depths[tweet.InReplyToStatusID]; ok {
+					depths[tweet.ID] = depth + 1
+				}
+				if seenTweets[tweet.ID] {
+					continue
+				}
+				seenTweets[tweet.ID] = true
+
+				if opts.MaxDepth > 0 && depths[tweet.ID] > opts.MaxDepth {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					channel <- &TweetResultTimeline{Error: ctx.Err()}
+					return
+				case channel <- &TweetResultTimeline{Tweet: *tweet}:
+				}
+				tweetsNbr++
+				if opts.MaxTweets > 0 && tweetsNbr >= opts.MaxTweets {
+					return
+				}
+			}
+
+			for _, next := range nextCursors {
+				if seenCursors[next.Cursor] {
+					continue
+				}
+				seenCursors[next.Cursor] = true
+				if depth, ok := depths[next.ThreadID]; ok && opts.MaxDepth > 0 && depth >= opts.MaxDepth {
+					continue
+				}
+				cursors = append(cursors, next)
+			}
+		}
+	}()
+	return channel
+}
+
+func newConversation(id string, tweets []*Tweet) *Conversation {
+	conversation := &Conversation{Nodes: make(map[string]*ConversationNode)}
+
+	var rootID string
+	for _, tweet := range tweets {
+		conversation.Nodes[tweet.ID] = &ConversationNode{Tweet: tweet}
+		if tweet.ID == id {
+			rootID = tweet.ConversationID
+		}
+	}
+
+	for _, tweet := range tweets {
+		node := conversation.Nodes[tweet.ID]
+		if tweet.ID == rootID {
+			conversation.Root = node
+			continue
+		}
+		if parent, ok := conversation.Nodes[tweet.InReplyToStatusID]; ok && tweet.InReplyToStatusID != "" {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+			tweet.InReplyToStatus = parent.Tweet
+		} else {
+			conversation.Orphans = append(conversation.Orphans, node)
+		}
+	}
+
+	// Without root tweet the topmost ancestor of focal tweet is used
+	if conversation.Root == nil {
+		if node, ok := conversation.Nodes[id]; ok {
+			for node.Parent != nil {
+				node = node.Parent
+			}
+			conversation.Root = node
+			conversation.Orphans = removeNode(conversation.Orphans, node)
+		}
+	}
+
+	for _, node := range conversation.Nodes {
+		sort.SliceStable(node.Children, func(i, j int) bool {
+			return node.Children[i].Tweet.Timestamp < node.Children[j].Tweet.Timestamp
+		})
+	}
+
+	if conversation.Root != nil {
+		conversation.Root.walk(0, conversation.Root.Tweet.UserID)
+	}
+	for _, node := range conversation.Orphans {
+		node.walk(0, "")
+	}
+
+	return conversation
+}
+
+// walk sets depth and self-thread markers of node and its children.
+func (node *ConversationNode) walk(depth int, authorID string) {
+	node.Depth = depth
+	if node.Parent == nil {
+		node.IsSelfThread = node.Tweet.IsSelfThread
+	} else {
+		node.IsSelfThread = authorID != "" && node.Tweet.UserID == authorID &&
+			(node.Parent.Parent == nil || node.Parent.IsSelfThread)
+	}
+	for _, child := range node.Children {
+		child.walk(depth+1, authorID)
+	}
+}
+
+// prune removes nodes deeper than maxDepth.
+func (conversation *Conversation) prune(maxDepth int) {
+	for id, node := range conversation.Nodes {
+		if node.Depth > maxDepth {
+			delete(conversation.Nodes, id)
+		} else if node.Depth == maxDepth {
+			node.Children = nil
+		}
+	}
+}
+
+func removeNode(nodes []*ConversationNode, node *ConversationNode) []*ConversationNode {
+	for i, n := range nodes {
+		if n == node {
+			return append(nodes[:i], nodes[i+1:]...)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,92 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+
+	twitterscraper "github.com/0x090909/twitter-go"
+)
+
+func TestGetConversation(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	tweetID := "1697304622749086011"
+
+	conversation, err := testScraper.GetConversation(context.Background(), tweetID, &twitterscraper.ConversationOptions{MaxDepth: 2, MaxTweets: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conversation.Root == nil || conversation.Root.Tweet.ID != tweetID {
+		t.Fatal("Expected conversation root is the tweet")
+	}
+	if len(conversation.Nodes) > 50 {
+		t.Errorf("Expected at most 50 tweets, got %d", len(conversation.Nodes))
+	}
+	for _, node := range conversation.Nodes {
+		if node.Depth > 2 {
+			t.Errorf("Expected depth at most 2, got %d for tweet %s", node.Depth, node.Tweet.ID)
+		}
+		for _, child := range node.Children {
+			if child.Parent != node || child.Depth != node.Depth+1 {
+				t.Errorf("Wrong parent or depth of tweet %s", child.Tweet.ID)
+			}
+		}
+	}
+}
+
+func TestGetConversationOfReply(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	rootID := "1697304622749086011"
+	tweets, _, err := testScraper.GetTweetReplies(rootID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var replyID string
+	for _, tweet := range tweets {
+		if tweet.InReplyToStatusID == rootID {
+			replyID = tweet.ID
+			break
+		}
+	}
+	if replyID == "" {
+		t.Skip("No replies to root tweet found")
+	}
+
+	conversation, err := testScraper.GetConversation(context.Background(), replyID, &twitterscraper.ConversationOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conversation.Root == nil || conversation.Root.Tweet.ID != rootID {
+		t.Fatal("Expected conversation root is the root tweet")
+	}
+	if _, ok := conversation.Nodes[replyID]; !ok {
+		t.Errorf("Expected conversation contains reply %s", replyID)
+	}
+	for _, node := range conversation.Nodes {
+		if node.Depth > 1 {
+			t.Errorf("Expected depth at most 1, got %d for tweet %s", node.Depth, node.Tweet.ID)
+		}
+	}
+
+	depths := make(map[string]int)
+	for tweet := range testScraper.GetConversationTweets(context.Background(), replyID, &twitterscraper.ConversationOptions{MaxDepth: 1}) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.ID == rootID {
+			depths[tweet.ID] = 0
+		} else if depth, ok := depths[tweet.InReplyToStatusID]; ok {
+			depths[tweet.ID] = depth + 1
+		}
+		if depths[tweet.ID] > 1 {
+			t.Errorf("Expected streamed depth at most 1, got %d for tweet %s", depths[tweet.ID], tweet.ID)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package twitterscraper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	// delay between requests of DM updates
+	dmUpdatesInterval = 10 * time.Second
+	// maximum delay between retries after errors
+	dmUpdatesMaxBackoff = 5 * time.Minute
+)
+
+// DMEventType is kind of direct messages event.
+type DMEventType string
+
+const (
+	// DMEventMessage - new message
+	DMEventMessage DMEventType = "message"
+	// DMEventMessageDelete - message was deleted
+	DMEventMessageDelete DMEventType = "message_delete"
+	// DMEventReaction - reaction added to message
+	DMEventReaction DMEventType = "reaction_create"
+	// DMEventReactionDelete - reaction removed from message
+	DMEventReactionDelete DMEventType = "reaction_delete"
+	// DMEventRead - conversation was read up to message
+	DMEventRead DMEventType = "conversation_read"
+	// DMEventJoin - participants joined group conversation
+	DMEventJoin DMEventType = "participants_join"
+	// DMEventLeave - participants left group conversation
+	DMEventLeave DMEventType = "participants_leave"
+	// DMEventRename - group conversation was renamed
+	DMEventRename DMEventType = "conversation_name_update"
+	// DMEventAccept - message request was accepted
+	DMEventAccept DMEventType = "trust_conversation"
+)
+
+// DMEvent is new message or conversation event of the logged in account.
+type DMEvent struct {
+	ID             string
+	Type           DMEventType
+	ConversationID string
+	UserID         string   // user who triggered event
+	UserIDs        []string // joined or left participants
+	Message        *DMMessage
+	Reaction       *DMReaction
+	MessageID      string // deleted, reacted or last read message
+	Name           string // new name of group conversation
+	Timestamp      time.Time
+}
+
+func (timeline *dmTimeline) parseEvents() []*DMEvent {
+	var events []*DMEvent
+	for _, entry := range timeline.Entries {
+		var event *DMEvent
+		switch {
+		case entry.Message != nil:
+			message := timeline.parseMessage(entry.Message)
+			event = &DMEvent{
+				ID:             message.ID,
+				Type:           DMEventMessage,
+				ConversationID: message.ConversationID,
+				UserID:         message.SenderID,
+				Message:        message,
+				MessageID:      message.ID,
+				Timestamp:      message.Timestamp,
+			}
+		case entry.ReactionCreate != nil:
+			event = parseDMEvent(DMEventReaction, entry.ReactionCreate)
+		case entry.ReactionDelete != nil:
+			event = parseDMEvent(DMEventReactionDelete, entry.ReactionDelete)
+		case entry.MessageDelete != nil:
+			event = parseDMEvent(DMEventMessageDelete, entry.MessageDelete)
+		case entry.ConversationRead != nil:
+			event = parseDMEvent(DMEventRead, entry.ConversationRead)
+		case entry.JoinConversation != nil:
+			event = parseDMEvent(DMEventJoin, entry.JoinConversation)
+		case entry.ParticipantsJoin != nil:
+			event = parseDMEvent(DMEventJoin, entry.ParticipantsJoin)
+		case entry.ParticipantsLeave != nil:
+			event = parseDMEvent(DMEventLeave, entry.ParticipantsLeave)
+		case entry.ConversationNameUpdate != nil:
+			event = parseDMEvent(DMEventRename, entry.ConversationNameUpdate)
+		case entry.TrustConversation != nil:
+			event = parseDMEvent(DMEventAccept, entry.TrustConversation)
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return sortIndexGreater(events[j].ID, events[i].ID)
+	})
+	return events
+}
+
+func parseDMEvent(eventType DMEventType, e *dmEvent) *DMEvent {
+	event := &DMEvent{
+		ID:             e.ID,
+		Type:           eventType,
+		ConversationID: e.ConversationID,
+		UserID:         e.SenderID,
+		MessageID:      e.MessageID,
+		Name:           e.ConversationName,
+		Timestamp:      parseTimestampMs(e.Time),
+	}
+	if event.UserID == "" {
+		event.UserID = e.ByUserID
+	}
+	for _, participant := range e.Participants {
+		event.UserIDs = append(event.UserIDs, participant.UserID)
+	}
+	switch eventType {
+	case DMEventReaction, DMEventReactionDelete:
+		event.Reaction = &DMReaction{
+			ID:        e.ID,
+			MessageID: e.MessageID,
+			SenderID:  e.SenderID,
+			Emoji:     e.EmojiReaction,
+			Key:       e.ReactionKey,
+			Timestamp: event.Timestamp,
+		}
+	case DMEventRead:
+		event.MessageID = e.LastReadEventID
+	case DMEventMessageDelete:
+		if len(e.Messages) > 0 {
+			event.MessageID = e.Messages[0].MessageID
+		}
+	}
+	return event
+}
+
+// SetDMCursor sets cursor of DM updates, WatchDMs emits only events after it.
+// Use it with GetDMCursor to continue watching after restart.
+func (s *Scraper) SetDMCursor(cursor string) *Scraper {
+	s.watchMutex.Lock()
+	defer s.watchMutex.Unlock()
+	s.dmCursor = cursor
+	return s
+}
+
+// GetDMCursor returns cursor of the last DM updates received by WatchDMs.
+func (s *Scraper) GetDMCursor() string {
+	s.watchMutex.Lock()
+	defer s.watchMutex.Unlock()
+	return s.dmCursor
+}
+
+// WatchDMs returns channel with new direct messages and conversation events of the logged in account.
+// Without DM cursor it starts from the current inbox state. Errors are sent to channel and requests
+// are retried from the saved cursor with growing delay, so no events are lost after reconnection.
+func (s *Scraper) WatchDMs(ctx context.Context) <-chan *DMEventResult {
+	channel := make(chan *DMEventResult)
+	go func() {
+		defer close(channel)
+		delay := dmUpdatesInterval
+		for {
+			events, cursor, err := s.fetchDMUpdates()
+			if err != nil {
+				if delay *= 2; delay > dmUpdatesMaxBackoff {
+					delay = dmUpdatesMaxBackoff
+				}
+				select {
+				case channel <- &DMEventResult{Error: err}:
+				case <-ctx.Done():
+					return
+				}
+			} else {
+				delay = dmUpdatesInterval
+				for _, event := range events {
+					select {
+					case channel <- &DMEventResult{DMEvent: *event}:
+					case <-ctx.Done():
+						return
+					}
+				}
+				if cursor != "" {
+					s.SetDMCursor(cursor)
+				}
+			}
+
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+// fetchDMUpdates returns events after DM cursor and cursor for the next request.
+// Without cursor it returns only the current cursor of inbox.
+func (s *Scraper) fetchDMUpdates() ([]*DMEvent, string, error) {
+	cursor := s.GetDMCursor()
+	if cursor == "" {
+		inbox, err := s.GetDMInbox()
+		if err != nil {
+			return nil, "", err
+		}
+		if inbox.Cursor == "" {
+			return nil, "", errors.New("DM cursor not found")
+		}
+		return nil, inbox.Cursor, nil
+	}
+
+	timeline, err := s.getDMUpdates(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	return timeline.parseEvents(), timeline.Cursor, nil
+}
+
+func (s *Scraper) getDMUpdates(cursor string) (*dmTimeline, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("GET", "https://x.com/i/api/1.1/dm/user_updates.json")
+	if err != nil {
+		return nil, err
+	}
+	q := dmQuery()
+	q.Set("cursor", cursor)
+	req.URL.RawQuery = q.Encode()
+
+	var response struct {
+		UserEvents dmTimeline `json:"user_events"`
+	}
+	err = s.RequestAPI(req, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.UserEvents, nil
+}
@@ -0,0 +1,51 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/0x090909/twitter-go"
+)
+
+func TestWatchDMs(t *testing.T) {
+	if skipAuthTest || username == "" || os.Getenv("TEST_SEND_DM") == "" {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	profile, err := testScraper.GetProfile(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	events := testScraper.WatchDMs(ctx)
+	// wait for cursor of the current inbox state
+	for testScraper.GetDMCursor() == "" && ctx.Err() == nil {
+		time.Sleep(time.Second)
+	}
+
+	message, err := testScraper.SendDM(twitterscraper.NewDM{UserID: profile.UserID, Text: "scraper watch test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := testScraper.DeleteDM(message.ID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	for event := range events {
+		if event.Error != nil {
+			t.Fatal(event.Error)
+		}
+		if event.Type == twitterscraper.DMEventMessage && event.MessageID == message.ID {
+			cancel()
+			return
+		}
+	}
+	t.Errorf("Expected message event %s", message.ID)
+}
@@ -0,0 +1,628 @@
+package twitterscraper
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DMConversation is direct messages conversation of the logged in account.
+type DMConversation struct {
+	ID              string
+	Type            string // ONE_TO_ONE or GROUP_DM
+	Name            string
+	Participants    []*Profile
+	LastMessage     *DMMessage
+	LastReadEventID string
+	SortEventID     string
+	UpdatedAt       time.Time
+	IsUnread        bool
+	IsTrusted       bool // false for message requests
+	IsMuted         bool
+	IsReadOnly      bool
+}
+
+// DMMessage is direct message of conversation.
+type DMMessage struct {
+	ID             string
+	ConversationID string
+	SenderID       string
+	RecipientID    string
+	Sender         *Profile
+	Text           string
+	Hashtags       []string
+	Mentions       []Mention
+	URLs           []string
+	Photos         []Photo
+	Videos         []Video
+	GIFs           []GIF
+	SharedTweet    *Tweet
+	Reactions      []DMReaction
+	Timestamp      time.Time
+}
+
+// DMReaction is emoji reaction to direct message.
+type DMReaction struct {
+	ID        string
+	MessageID string
+	SenderID  string
+	Emoji     string
+	Key       string
+	Timestamp time.Time
+}
+
+// DMInbox of the logged in account.
+type DMInbox struct {
+	Conversations   []*DMConversation
+	Requests        []*DMConversation
+	Cursor          string
+	LastSeenEventID string
+}
+
+// NewDM is direct message to send. Set ConversationID to reply in existing conversation
+// or UserID to message user directly.
+type NewDM struct {
+	ConversationID string
+	UserID         string
+	Text           string
+	Media          *Media // uploaded with UploadDMMedia
+	TweetID        string // tweet shared as link
+}
+
+type dmReaction struct {
+	ID             string `json:"id"`
+	Time           string `json:"time"`
+	ConversationID string `json:"conversation_id"`
+	MessageID      string `json:"message_id"`
+	ReactionKey    string `json:"reaction_key"`
+	EmojiReaction  string `json:"emoji_reaction"`
+	SenderID       string `json:"sender_id"`
+}
+
+type dmMessage struct {
+	ID             string `json:"id"`
+	Time           string `json:"time"`
+	ConversationID string `json:"conversation_id"`
+	MessageData    struct {
+		ID          string `json:"id"`
+		Time        string `json:"time"`
+		RecipientID string `json:"recipient_id"`
+		SenderID    string `json:"sender_id"`
+		Text        string `json:"text"`
+		Entities    struct {
+			Hashtags []struct {
+				Text string `json:"text"`
+			} `json:"hashtags"`
+			UserMentions []struct {
+				IDStr      string `json:"id_str"`
+				Name       string `json:"name"`
+				ScreenName string `json:"screen_name"`
+			} `json:"user_mentions"`
+			URLs []Url `json:"urls"`
+		} `json:"entities"`
+		Attachment struct {
+			Photo       *ExtendedMedia `json:"photo"`
+			Video       *ExtendedMedia `json:"video"`
+			AnimatedGIF *ExtendedMedia `json:"animated_gif"`
+			Tweet       *struct {
+				ID          string `json:"id"`
+				ExpandedURL string `json:"expanded_url"`
+				Status      *struct {
+					legacyTweet
+					User legacyUser `json:"user"`
+				} `json:"status"`
+			} `json:"tweet"`
+		} `json:"attachment"`
+	} `json:"message_data"`
+	MessageReactions []dmReaction `json:"message_reactions"`
+}
+
+type dmEvent struct {
+	ID               string `json:"id"`
+	Time             string `json:"time"`
+	ConversationID   string `json:"conversation_id"`
+	SenderID         string `json:"sender_id"`
+	ByUserID         string `json:"by_user_id"`
+	MessageID        string `json:"message_id"`
+	ReactionKey      string `json:"reaction_key"`
+	EmojiReaction    string `json:"emoji_reaction"`
+	LastReadEventID  string `json:"last_read_event_id"`
+	ConversationName string `json:"conversation_name"`
+	Participants     []struct {
+		UserID string `json:"user_id"`
+	} `json:"participants"`
+	Messages []struct {
+		MessageID string `json:"message_id"`
+	} `json:"messages"`
+}
+
+type dmEntry struct {
+	Message                *dmMessage `json:"message"`
+	ReactionCreate         *dmEvent   `json:"reaction_create"`
+	ReactionDelete         *dmEvent   `json:"reaction_delete"`
+	MessageDelete          *dmEvent   `json:"message_delete"`
+	ConversationRead       *dmEvent   `json:"conversation_read"`
+	JoinConversation       *dmEvent   `json:"join_conversation"`
+	ParticipantsJoin       *dmEvent   `json:"participants_join"`
+	ParticipantsLeave      *dmEvent   `json:"participants_leave"`
+	ConversationNameUpdate *dmEvent   `json:"conversation_name_update"`
+	TrustConversation      *dmEvent   `json:"trust_conversation"`
+}
+
+type dmConversation struct {
+	ConversationID  string `json:"conversation_id"`
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	SortEventID     string `json:"sort_event_id"`
+	SortTimestamp   string `json:"sort_timestamp"`
+	LastReadEventID string `json:"last_read_event_id"`
+	Participants    []struct {
+		UserID          string `json:"user_id"`
+		LastReadEventID string `json:"last_read_event_id"`
+	} `json:"participants"`
+	Trusted  bool   `json:"trusted"`
+	Muted    bool   `json:"muted"`
+	ReadOnly bool   `json:"read_only"`
+	Status   string `json:"status"`
+}
+
+type dmTimeline struct {
+	Cursor          string                    `json:"cursor"`
+	LastSeenEventID string                    `json:"last_seen_event_id"`
+	Status          string                    `json:"status"`
+	MinEntryID      string                    `json:"min_entry_id"`
+	MaxEntryID      string                    `json:"max_entry_id"`
+	Entries         []dmEntry                 `json:"entries"`
+	Users           map[string]legacyUser     `json:"users"`
+	Conversations   map[string]dmConversation `json:"conversations"`
+}
+
+func (timeline *dmTimeline) parseMessages() []*DMMessage {
+	var messages []*DMMessage
+	for _, entry := range timeline.Entries {
+		if entry.Message != nil {
+			messages = append(messages, timeline.parseMessage(entry.Message))
+		}
+	}
+	return messages
+}
+
+func (timeline *dmTimeline) parseMessage(msg *dmMessage) *DMMessage {
+	data := msg.MessageData
+	attachment := data.Attachment
+	var media []ExtendedMedia
+	if attachment.Photo != nil {
+		attachment.Photo.Type = "photo"
+		media = append(media, *attachment.Photo)
+	}
+	if attachment.Video != nil {
+		attachment.Video.Type = "video"
+		media = append(media, *attachment.Video)
+	}
+	if attachment.AnimatedGIF != nil {
+		attachment.AnimatedGIF.Type = "animated_gif"
+		media = append(media, *attachment.AnimatedGIF)
+	}
+
+	message := &DMMessage{
+		ID:             msg.ID,
+		ConversationID: msg.ConversationID,
+		SenderID:       data.SenderID,
+		RecipientID:    data.RecipientID,
+		Text:           expandURLs(data.Text, data.Entities.URLs, media),
+		Timestamp:      parseTimestampMs(msg.Time),
+	}
+	if user, ok := timeline.Users[data.SenderID]; ok {
+		sender := parseProfile(user)
+		if sender.UserID == "" {
+			sender.UserID = data.SenderID
+		}
+		message.Sender = &sender
+	}
+	for _, hash := range data.Entities.Hashtags {
+		message.Hashtags = append(message.Hashtags, hash.Text)
+	}
+	for _, mention := range data.Entities.UserMentions {
+		message.Mentions = append(message.Mentions, Mention{
+			ID:       mention.IDStr,
+			Username: mention.ScreenName,
+			Name:     mention.Name,
+		})
+	}
+	for _, url := range data.Entities.URLs {
+		message.URLs = append(message.URLs, url.ExpandedURL)
+	}
+
+	for _, m := range media {
+		photo, video, gif := parseExtendedMedia(m)
+		if photo != nil {
+			message.Photos = append(message.Photos, *photo)
+		} else if video != nil {
+			message.Videos = append(message.Videos, *video)
+		} else if gif != nil {
+			message.GIFs = append(message.GIFs, *gif)
+		}
+	}
+
+	if shared := attachment.Tweet; shared != nil {
+		if shared.Status != nil {
+			message.SharedTweet = parseLegacyTweet(&shared.Status.User, &shared.Status.legacyTweet)
+		}
+		if message.SharedTweet == nil && shared.ID != "" {
+			message.SharedTweet = &Tweet{ID: shared.ID, PermanentURL: shared.ExpandedURL}
+		}
+	}
+
+	for _, reaction := range msg.MessageReactions {
+		message.Reactions = append(message.Reactions, parseDMReaction(reaction))
+	}
+	return message
+}
+
+func parseDMReaction(reaction dmReaction) DMReaction {
+	return DMReaction{
+		ID:        reaction.ID,
+		MessageID: reaction.MessageID,
+		SenderID:  reaction.SenderID,
+		Emoji:     reaction.EmojiReaction,
+		Key:       reaction.ReactionKey,
+		Timestamp: parseTimestampMs(reaction.Time),
+	}
+}
+
+func (timeline *dmTimeline) parseConversation(conv dmConversation) *DMConversation {
+	conversation := &DMConversation{
+		ID:              conv.ConversationID,
+		Type:            conv.Type,
+		Name:            conv.Name,
+		LastReadEventID: conv.LastReadEventID,
+		SortEventID:     conv.SortEventID,
+		UpdatedAt:       parseTimestampMs(conv.SortTimestamp),
+		IsUnread:        sortIndexGreater(conv.SortEventID, conv.LastReadEventID),
+		IsTrusted:       conv.Trusted,
+		IsMuted:         conv.Muted,
+		IsReadOnly:      conv.ReadOnly,
+	}
+	for _, participant := range conv.Participants {
+		if user, ok := timeline.Users[participant.UserID]; ok {
+			profile := parseProfile(user)
+			if profile.UserID == "" {
+				profile.UserID = participant.UserID
+			}
+			conversation.Participants = append(conversation.Participants, &profile)
+		} else {
+			conversation.Participants = append(conversation.Participants, &Profile{UserID: participant.UserID})
+		}
+	}
+	return conversation
+}
+
+// parseConversations returns conversations of timeline sorted from the latest updated, with their last messages.
+func (timeline *dmTimeline) parseConversations() []*DMConversation {
+	lastMessages := make(map[string]*DMMessage)
+	for _, message := range timeline.parseMessages() {
+		last, ok := lastMessages[message.ConversationID]
+		if !ok || sortIndexGreater(message.ID, last.ID) {
+			lastMessages[message.ConversationID] = message
+		}
+	}
+
+	var conversations []*DMConversation
+	for _, conv := range timeline.Conversations {
+		conversation := timeline.parseConversation(conv)
+		conversation.LastMessage = lastMessages[conversation.ID]
+		conversations = append(conversations, conversation)
+	}
+	sort.Slice(conversations, func(i, j int) bool {
+		return sortIndexGreater(conversations[i].SortEventID, conversations[j].SortEventID)
+	})
+	return conversations
+}
+
+// GetDMInbox returns conversations and message requests of the logged in account.
+func (s *Scraper) GetDMInbox() (*DMInbox, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("GET", "https://x.com/i/api/1.1/dm/inbox_initial_state.json")
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = dmQuery().Encode()
+
+	var response struct {
+		InboxInitialState dmTimeline `json:"inbox_initial_state"`
+	}
+	err = s.RequestAPI(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := response.InboxInitialState
+	inbox := &DMInbox{
+		Cursor:          timeline.Cursor,
+		LastSeenEventID: timeline.LastSeenEventID,
+	}
+	for _, conversation := range timeline.parseConversations() {
+		if conversation.IsTrusted {
+			inbox.Conversations = append(inbox.Conversations, conversation)
+		} else {
+			inbox.Requests = append(inbox.Requests, conversation)
+		}
+	}
+	return inbox, nil
+}
+
+// GetDMConversation returns all messages of conversation ordered from the oldest.
+func (s *Scraper) GetDMConversation(ctx context.Context, conversationID string) ([]*DMMessage, error) {
+	var messages []*DMMessage
+	var cursor string
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, next, err := s.FetchDMConversation(conversationID, cursor)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, page...)
+		if next == "" || next == cursor {
+			break
+		}
+		cursor = next
+	}
+
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+	return messages, nil
+}
+
+// FetchDMConversation gets page of conversation messages ordered from the newest, via the Twitter frontend API.
+// Returns cursor of older messages, empty at the beginning of conversation.
+func (s *Scraper) FetchDMConversation(conversationID string, cursor string) ([]*DMMessage, string, error) {
+	if !s.isLogged {
+		return nil, "", errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("GET", fmt.Sprintf("https://x.com/i/api/1.1/dm/conversation/%s.json", url.PathEscape(conversationID)))
+	if err != nil {
+		return nil, "", err
+	}
+	q := dmQuery()
+	q.Set("context", "FETCH_DM_CONVERSATION_HISTORY")
+	if cursor != "" {
+		q.Set("max_id", cursor)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	var response struct {
+		ConversationTimeline dmTimeline `json:"conversation_timeline"`
+	}
+	err = s.RequestAPI(req, &response)
+	if err != nil {
+		return nil, "", err
+	}
+
+	timeline := response.ConversationTimeline
+	var nextCursor string
+	if timeline.Status == "HAS_MORE" {
+		nextCursor = timeline.MinEntryID
+	}
+	return timeline.parseMessages(), nextCursor, nil
+}
+
+func dmQuery() url.Values {
+	q := url.Values{}
+	q.Set("include_groups", "true")
+	q.Set("include_inbox_timelines", "true")
+	q.Set("include_conversation_info", "true")
+	q.Set("include_ext_media_availability", "true")
+	q.Set("include_ext_alt_text", "true")
+	q.Set("supports_reactions", "true")
+	q.Set("supports_edit", "true")
+	q.Set("dm_users", "true")
+	q.Set("tweet_mode", "extended")
+	return q
+}
+
+func parseTimestampMs(ms string) time.Time {
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, n*int64(time.Millisecond))
+}
+
+// SendDM sends direct message from the logged in account.
+func (s *Scraper) SendDM(dm NewDM) (*DMMessage, error) {
+	body := map[string]interface{}{}
+	if dm.ConversationID != "" {
+		body["conversation_id"] = dm.ConversationID
+	} else if dm.UserID != "" {
+		body["recipient_ids"] = dm.UserID
+	} else {
+		return nil, errors.New("conversation or user is required")
+	}
+
+	text := dm.Text
+	if dm.TweetID != "" {
+		text = strings.TrimSpace(text + " https://x.com/i/status/" + dm.TweetID)
+	}
+	body["text"] = text
+	if dm.Media != nil {
+		body["media_id"] = strconv.Itoa(dm.Media.ID)
+	}
+
+	return s.sendDM(body)
+}
+
+// CreateDMGroup creates group conversation with users by sending first message to them.
+// Conversation ID of the group is in ConversationID of returned message.
+func (s *Scraper) CreateDMGroup(userIDs []string, text string) (*DMMessage, error) {
+	if len(userIDs) < 2 {
+		return nil, errors.New("group conversation requires at least two users")
+	}
+	return s.sendDM(map[string]interface{}{
+		"recipient_ids": strings.Join(userIDs, ","),
+		"text":          text,
+	})
+}
+
+func (s *Scraper) sendDM(body map[string]interface{}) (*DMMessage, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("POST", "https://x.com/i/api/1.1/dm/new2.json")
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = dmQuery().Encode()
+	req.Header.Set("content-type", "application/json")
+
+	body["request_id"] = newRequestID()
+	body["cards_platform"] = "Web-12"
+	body["include_cards"] = 1
+	b, _ := json.Marshal(body)
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	var timeline dmTimeline
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := timeline.parseMessages()
+	if len(messages) == 0 {
+		return nil, errors.New("message wasn't sent")
+	}
+	return messages[0], nil
+}
+
+// DeleteDM deletes direct message for the logged in account.
+func (s *Scraper) DeleteDM(messageID string) error {
+	return s.mutateDM("BJ6DtxA2llfjnRoRjaiIiw", "DMMessageDeleteMutation", map[string]interface{}{
+		"messageId": messageID,
+		"requestId": newRequestID(),
+	})
+}
+
+// ReactToDM adds emoji reaction to direct message.
+func (s *Scraper) ReactToDM(conversationID string, messageID string, emoji string) error {
+	return s.mutateDM("VyDyV9pC2oZEj6g52hgnhA", "useDMReactionMutationAddMutation", map[string]interface{}{
+		"conversationId": conversationID,
+		"messageId":      messageID,
+		"reactionTypes":  []string{"Emoji"},
+		"emojiReactions": []string{emoji},
+	})
+}
+
+// RemoveDMReaction removes emoji reaction from direct message.
+func (s *Scraper) RemoveDMReaction(conversationID string, messageID string, emoji string) error {
+	return s.mutateDM("bV_Nim3RYHsaJwMkTXJ6ew", "useDMReactionMutationRemoveMutation", map[string]interface{}{
+		"conversationId": conversationID,
+		"messageId":      messageID,
+		"reactionTypes":  []string{"Emoji"},
+		"emojiReactions": []string{emoji},
+	})
+}
+
+// MarkDMRead marks conversation as read up to message.
+func (s *Scraper) MarkDMRead(conversationID string, messageID string) error {
+	form := url.Values{}
+	form.Set("conversationId", conversationID)
+	form.Set("last_read_event_id", messageID)
+	return s.postDMConversation(conversationID, "mark_read", form)
+}
+
+// AcceptDMRequest moves message request to conversations of the logged in account.
+func (s *Scraper) AcceptDMRequest(conversationID string) error {
+	return s.postDMConversation(conversationID, "accept", url.Values{})
+}
+
+// DeclineDMRequest deletes message request.
+func (s *Scraper) DeclineDMRequest(conversationID string) error {
+	form := url.Values{}
+	form.Set("dm_secret_conversations_enabled", "false")
+	return s.postDMConversation(conversationID, "delete", form)
+}
+
+// RenameDMGroup changes name of group conversation.
+func (s *Scraper) RenameDMGroup(conversationID string, name string) error {
+	form := url.Values{}
+	form.Set("name", name)
+	return s.postDMConversation(conversationID, "update_name", form)
+}
+
+func (s *Scraper) postDMConversation(conversationID string, action string, form url.Values) error {
+	if !s.isLogged {
+		return errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("POST", fmt.Sprintf("https://x.com/i/api/1.1/dm/conversation/%s/%s.json", url.PathEscape(conversationID), action))
+	if err != nil {
+		return err
+	}
+
+	req.URL.RawQuery = ""
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Body = io.NopCloser(strings.NewReader(form.Encode()))
+
+	return s.RequestAPI(req, nil)
+}
+
+func (s *Scraper) mutateDM(queryID string, operation string, variables map[string]interface{}) error {
+	if !s.isLogged {
+		return errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("POST", "https://x.com/i/api/graphql/"+queryID+"/"+operation)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	body := map[string]interface{}{
+		"variables": variables,
+		"queryId":   queryID,
+	}
+
+	b, _ := json.Marshal(body)
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	err = s.RequestAPI(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		return errors.New(response.Errors[0].Message)
+	}
+	return nil
+}
+
+// newRequestID returns random UUID used to deduplicate sent messages.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
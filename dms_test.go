@@ -0,0 +1,106 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	twitterscraper "github.com/0x090909/twitter-go"
+)
+
+func TestGetDMInbox(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	inbox, err := testScraper.GetDMInbox()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inbox.Cursor == "" {
+		t.Error("Expected inbox Cursor is empty")
+	}
+	for _, conversation := range append(inbox.Conversations, inbox.Requests...) {
+		if conversation.ID == "" {
+			t.Error("Expected conversation ID is empty")
+		}
+		if len(conversation.Participants) == 0 {
+			t.Errorf("Expected conversation %s has participants", conversation.ID)
+		}
+	}
+	for _, conversation := range inbox.Requests {
+		if conversation.IsTrusted {
+			t.Errorf("Expected message request %s is not trusted", conversation.ID)
+		}
+	}
+}
+
+func TestGetDMConversation(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	inbox, err := testScraper.GetDMInbox()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inbox.Conversations) == 0 {
+		t.Skip("No DM conversations")
+	}
+
+	conversation := inbox.Conversations[0]
+	messages, err := testScraper.GetDMConversation(context.Background(), conversation.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, message := range messages {
+		if message.ID == "" {
+			t.Error("Expected message ID is empty")
+		}
+		if message.ConversationID != conversation.ID {
+			t.Errorf("Expected message ConversationID %s, got %s", conversation.ID, message.ConversationID)
+		}
+		if i > 0 && message.Timestamp.Before(messages[i-1].Timestamp) {
+			t.Errorf("Expected messages ordered from the oldest, got %s before %s", messages[i-1].ID, message.ID)
+		}
+	}
+}
+
+func TestSendDM(t *testing.T) {
+	if skipAuthTest || username == "" || os.Getenv("TEST_SEND_DM") == "" {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	profile, err := testScraper.GetProfile(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message, err := testScraper.SendDM(twitterscraper.NewDM{
+		UserID:  profile.UserID,
+		Text:    "scraper test",
+		TweetID: "1328684389388185600",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := testScraper.DeleteDM(message.ID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if message.ConversationID == "" {
+		t.Error("Expected message ConversationID is empty")
+	}
+	if message.SenderID != profile.UserID {
+		t.Errorf("Expected message SenderID %s, got %s", profile.UserID, message.SenderID)
+	}
+
+	if err := testScraper.ReactToDM(message.ConversationID, message.ID, "👍"); err != nil {
+		t.Error(err)
+	}
+	if err := testScraper.MarkDMRead(message.ConversationID, message.ID); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,89 @@
+package twitterscraper
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetHighlightTweets returns channel with tweets highlighted by a given user.
+func (s *Scraper) GetHighlightTweets(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResultTimeline {
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchHighlightTweets)
+}
+
+// FetchHighlightTweets gets tweets highlighted by a given user, via the Twitter frontend API.
+func (s *Scraper) FetchHighlightTweets(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.FetchHighlightTweetsByUserID(userID, maxTweetsNbr, cursor)
+}
+
+// FetchHighlightTweetsByUserID gets tweets highlighted by a given userID, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchHighlightTweetsByUserID(userID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.fetchProfileTabTweets("https://x.com/i/api/graphql/Rgf5svBBwYE-AVuOlwBzhA/UserHighlightsTweets", userID, maxTweetsNbr, cursor)
+}
+
+// fetchProfileTabTweets gets tweets of user profile tab like highlights or articles.
+func (s *Scraper) fetchProfileTabTweets(endpoint string, userID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if maxTweetsNbr > 200 {
+		maxTweetsNbr = 200
+	}
+
+	req, err := s.newRequest("GET", endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	variables := map[string]interface{}{
+		"userId":                 userID,
+		"count":                  maxTweetsNbr,
+		"includePromotedContent": false,
+		"withVoice":              true,
+	}
+	features := map[string]interface{}{
+		"rweb_tipjar_consumption_enabled":                                         true,
+		"responsive_web_graphql_exclude_directive_enabled":                        true,
+		"verified_phone_label_enabled":                                            false,
+		"creator_subscriptions_tweet_preview_api_enabled":                         true,
+		"responsive_web_graphql_timeline_navigation_enabled":                      true,
+		"responsive_web_graphql_skip_user_profile_image_extensions_enabled":       false,
+		"communities_web_enable_tweet_community_results_fetch":                    true,
+		"c9s_tweet_anatomy_moderator_badge_enabled":                               true,
+		"articles_preview_enabled":                                                true,
+		"tweetypie_unmention_optimization_enabled":                                true,
+		"responsive_web_edit_tweet_api_enabled":                                   true,
+		"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+		"view_counts_everywhere_api_enabled":                                      true,
+		"longform_notetweets_consumption_enabled":                                 true,
+		"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+		"tweet_awards_web_tipping_enabled":                                        false,
+		"creator_subscriptions_quote_tweet_preview_enabled":                       false,
+		"freedom_of_speech_not_reach_fetch_enabled":                               true,
+		"standardized_nudges_misinfo":                                             true,
+		"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": true,
+		"rweb_video_timestamps_enabled":                                           true,
+		"longform_notetweets_rich_text_read_enabled":                              true,
+		"longform_notetweets_inline_media_enabled":                                true,
+		"responsive_web_enhance_cards_enabled":                                    false,
+	}
+
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	query := url.Values{}
+	query.Set("variables", mapToJSONString(variables))
+	query.Set("features", mapToJSONString(features))
+	req.URL.RawQuery = query.Encode()
+
+	var timeline timelineV2
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tweets, nextCursor := timeline.parseTweets()
+	return tweets, nextCursor, nil
+}
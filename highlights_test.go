@@ -0,0 +1,39 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetHighlightTweets(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+	count := 0
+	for tweet := range testScraper.GetHighlightTweets(context.Background(), "elonmusk", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		count++
+		if tweet.ID == "" {
+			t.Error("Expected tweet ID is empty")
+		}
+	}
+	if count == 0 {
+		t.Error("Expected highlighted tweets")
+	}
+}
+
+func TestGetUserArticles(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+	for tweet := range testScraper.GetUserArticles(context.Background(), "XDevelopers", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.ID == "" {
+			t.Error("Expected tweet ID is empty")
+		}
+	}
+}
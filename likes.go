@@ -0,0 +1,102 @@
+package twitterscraper
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// GetLikedTweets returns channel with tweets liked by a given user.
+func (s *Scraper) GetLikedTweets(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResultTimeline {
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchLikedTweets)
+}
+
+// FetchLikedTweets gets tweets liked by a given user, via the Twitter frontend API.
+func (s *Scraper) FetchLikedTweets(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.FetchLikedTweetsByUserID(userID, maxTweetsNbr, cursor)
+}
+
+// FetchLikedTweetsByUserID gets tweets liked by a given userID, via the Twitter frontend GraphQL API.
+// Likes of other users are private, so only likes of the logged in account are returned.
+func (s *Scraper) FetchLikedTweetsByUserID(userID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if maxTweetsNbr > 200 {
+		maxTweetsNbr = 200
+	}
+
+	req, err := s.newRequest("GET", "https://x.com/i/api/graphql/aeJWz--kknVBOl7wQ7gh7Q/Likes")
+	if err != nil {
+		return nil, "", err
+	}
+
+	variables := map[string]interface{}{
+		"userId":                 userID,
+		"count":                  maxTweetsNbr,
+		"includePromotedContent": false,
+		"withClientEventToken":   false,
+		"withBirdwatchNotes":     false,
+		"withVoice":              true,
+		"withV2Timeline":         true,
+	}
+	features := map[string]interface{}{
+		"responsive_web_graphql_exclude_directive_enabled":                        true,
+		"verified_phone_label_enabled":                                            false,
+		"creator_subscriptions_tweet_preview_api_enabled":                         true,
+		"responsive_web_graphql_timeline_navigation_enabled":                      true,
+		"responsive_web_graphql_skip_user_profile_image_extensions_enabled":       false,
+		"c9s_tweet_anatomy_moderator_badge_enabled":                               true,
+		"tweetypie_unmention_optimization_enabled":                                true,
+		"responsive_web_edit_tweet_api_enabled":                                   true,
+		"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+		"view_counts_everywhere_api_enabled":                                      true,
+		"longform_notetweets_consumption_enabled":                                 true,
+		"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+		"tweet_awards_web_tipping_enabled":                                        false,
+		"freedom_of_speech_not_reach_fetch_enabled":                               true,
+		"standardized_nudges_misinfo":                                             true,
+		"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": true,
+		"rweb_video_timestamps_enabled":                                           true,
+		"longform_notetweets_rich_text_read_enabled":                              true,
+		"longform_notetweets_inline_media_enabled":                                true,
+		"responsive_web_media_download_video_enabled":                             false,
+		"responsive_web_enhance_cards_enabled":                                    false,
+	}
+
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	query := url.Values{}
+	query.Set("variables", mapToJSONString(variables))
+	query.Set("features", mapToJSONString(features))
+	req.URL.RawQuery = query.Encode()
+
+	var timeline timelineV2
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tweets, nextCursor := timeline.parseTweets()
+
+	// Sort index of likes timeline entry is snowflake ID of the like
+	likedAt := make(map[string]time.Time)
+	for _, instruction := range timeline.instructions() {
+		for _, entry := range instruction.Entries {
+			if tm, ok := snowflakeTime(entry.SortIndex); ok {
+				likedAt[tweetIDFromEntryID(entry.EntryID)] = tm
+			}
+		}
+	}
+	for _, tweet := range tweets {
+		if tm, ok := likedAt[tweet.ID]; ok && !tm.Before(tweet.TimeParsed) && tm.Before(time.Now()) {
+			tweet.LikedAt = tm
+		}
+	}
+
+	return tweets, nextCursor, nil
+}
@@ -0,0 +1,27 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLikedTweets(t *testing.T) {
+	if skipAuthTest || username == "" {
+		t.Skip("Skipping test due to environment variable")
+	}
+	dupcheck := make(map[string]bool)
+	for tweet := range testScraper.GetLikedTweets(context.Background(), username, 20) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.ID == "" {
+			t.Error("Expected tweet ID is empty")
+		} else if dupcheck[tweet.ID] {
+			t.Errorf("Detect duplicated tweet ID: %s", tweet.ID)
+		}
+		dupcheck[tweet.ID] = true
+		if !tweet.LikedAt.IsZero() && tweet.LikedAt.Before(tweet.TimeParsed) {
+			t.Errorf("Expected tweet %s liked after it was created", tweet.ID)
+		}
+	}
+}
@@ -0,0 +1,379 @@
+package twitterscraper
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// List of twitter users.
+type List struct {
+	ID              string
+	Name            string
+	Description     string
+	Mode            string
+	MemberCount     int
+	SubscriberCount int
+	Owner           *Profile
+	BannerURL       string
+	URL             string
+	CreatedAt       time.Time
+	IsMember        bool
+	IsSubscribed    bool
+	IsMuted         bool
+	IsPinned        bool
+}
+
+type listBanner struct {
+	MediaInfo struct {
+		OriginalImgURL string `json:"original_img_url"`
+	} `json:"media_info"`
+}
+
+type listResult struct {
+	Typename           string     `json:"__typename"`
+	IDStr              string     `json:"id_str"`
+	Name               string     `json:"name"`
+	Description        string     `json:"description"`
+	Mode               string     `json:"mode"`
+	MemberCount        int        `json:"member_count"`
+	SubscriberCount    int        `json:"subscriber_count"`
+	CreatedAt          int64      `json:"created_at"`
+	Following          bool       `json:"following"`
+	IsMember           bool       `json:"is_member"`
+	Muting             bool       `json:"muting"`
+	Pinning            bool       `json:"pinning"`
+	CustomBannerMedia  listBanner `json:"custom_banner_media"`
+	DefaultBannerMedia listBanner `json:"default_banner_media"`
+	UserResults        struct {
+		Result userResult `json:"result"`
+	} `json:"user_results"`
+}
+
+func (result *listResult) parse() *List {
+	if result.IDStr == "" {
+		return nil
+	}
+	list := &List{
+		ID:              result.IDStr,
+		Name:            result.Name,
+		Description:     result.Description,
+		Mode:            result.Mode,
+		MemberCount:     result.MemberCount,
+		SubscriberCount: result.SubscriberCount,
+		BannerURL:       result.CustomBannerMedia.MediaInfo.OriginalImgURL,
+		URL:             "https://x.com/i/lists/" + result.IDStr,
+		IsMember:        result.IsMember,
+		IsSubscribed:    result.Following,
+		IsMuted:         result.Muting,
+		IsPinned:        result.Pinning,
+	}
+	if list.BannerURL == "" {
+		list.BannerURL = result.DefaultBannerMedia.MediaInfo.OriginalImgURL
+	}
+	if result.CreatedAt > 0 {
+		list.CreatedAt = time.Unix(0, result.CreatedAt*int64(time.Millisecond))
+	}
+	if result.UserResults.Result.RestID != "" {
+		owner := result.UserResults.Result.parse()
+		if owner.UserID == "" {
+			owner.UserID = result.UserResults.Result.RestID
+		}
+		list.Owner = &owner
+	}
+	return list
+}
+
+type listTimeline struct {
+	Data struct {
+		List struct {
+			listResult
+			TweetsTimeline struct {
+				Timeline struct {
+					Instructions []instruction `json:"instructions"`
+				} `json:"timeline"`
+			} `json:"tweets_timeline"`
+			MembersTimeline struct {
+				Timeline struct {
+					Instructions []instruction `json:"instructions"`
+				} `json:"timeline"`
+			} `json:"members_timeline"`
+			SubscribersTimeline struct {
+				Timeline struct {
+					Instructions []instruction `json:"instructions"`
+				} `json:"timeline"`
+			} `json:"subscribers_timeline"`
+		} `json:"list"`
+	} `json:"data"`
+}
+
+func parseTimelineLists(instructions []instruction) ([]*List, string) {
+	var cursor string
+	var lists []*List
+	for _, instruction := range instructions {
+		for _, entry := range instruction.Entries {
+			if entry.Content.CursorType == "Bottom" {
+				cursor = entry.Content.Value
+				continue
+			}
+			if list := entry.Content.ItemContent.List.parse(); list != nil {
+				lists = append(lists, list)
+			}
+			for _, item := range entry.Content.Items {
+				if list := item.Item.ItemContent.List.parse(); list != nil {
+					lists = append(lists, list)
+				}
+			}
+		}
+	}
+	return lists, cursor
+}
+
+// GetList return list by ID.
+func (s *Scraper) GetList(listID string) (*List, error) {
+	var timeline listTimeline
+	err := s.requestList("https://x.com/i/api/graphql/9hbYpeVBMq8-yB8slayGWQ/ListByRestId", map[string]interface{}{
+		"listId": listID,
+	}, &timeline)
+	if err != nil {
+		return nil, err
+	}
+
+	list := timeline.Data.List.listResult.parse()
+	if list == nil {
+		return nil, errors.New("list not found")
+	}
+	return list, nil
+}
+
+// GetListTweets returns channel with latest tweets of a list.
+func (s *Scraper) GetListTweets(ctx context.Context, listID string, maxTweetsNbr int) <-chan *TweetResultTimeline {
+	return getTweetTimeline(ctx, listID, maxTweetsNbr, s.FetchListTweets)
+}
+
+// FetchListTweets gets latest tweets of a list, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListTweets(listID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if maxTweetsNbr > 200 {
+		maxTweetsNbr = 200
+	}
+
+	variables := map[string]interface{}{
+		"listId": listID,
+		"count":  maxTweetsNbr,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var timeline listTimeline
+	err := s.requestList("https://x.com/i/api/graphql/HjsWc-nwwHKYwHenbHm-tw/ListLatestTweetsTimeline", variables, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tweets, nextCursor := parseTimelineTweets(timeline.Data.List.TweetsTimeline.Timeline.Instructions)
+	return tweets, nextCursor, nil
+}
+
+// GetListMembers returns channel with members of a list.
+func (s *Scraper) GetListMembers(ctx context.Context, listID string, maxUsersNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, listID, maxUsersNbr, s.FetchListMembers)
+}
+
+// FetchListMembers gets members of a list, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListMembers(listID string, maxUsersNbr int, cursor string) ([]*Profile, string, error) {
+	if maxUsersNbr > 200 {
+		maxUsersNbr = 200
+	}
+
+	variables := map[string]interface{}{
+		"listId": listID,
+		"count":  maxUsersNbr,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var timeline listTimeline
+	err := s.requestList("https://x.com/i/api/graphql/BQp2IEYkgxuSxqbTAr1e1g/ListMembers", variables, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, nextCursor := parseTimelineUsers(timeline.Data.List.MembersTimeline.Timeline.Instructions)
+	if strings.HasPrefix(nextCursor, "0|") {
+		nextCursor = ""
+	}
+	return users, nextCursor, nil
+}
+
+// GetListSubscribers returns channel with subscribers of a list.
+func (s *Scraper) GetListSubscribers(ctx context.Context, listID string, maxUsersNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, listID, maxUsersNbr, s.FetchListSubscribers)
+}
+
+// FetchListSubscribers gets subscribers of a list, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListSubscribers(listID string, maxUsersNbr int, cursor string) ([]*Profile, string, error) {
+	if maxUsersNbr > 200 {
+		maxUsersNbr = 200
+	}
+
+	variables := map[string]interface{}{
+		"listId": listID,
+		"count":  maxUsersNbr,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var timeline listTimeline
+	err := s.requestList("https://x.com/i/api/graphql/74wGEkaBxrdoXakWTWMxRQ/ListSubscribers", variables, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, nextCursor := parseTimelineUsers(timeline.Data.List.SubscribersTimeline.Timeline.Instructions)
+	if strings.HasPrefix(nextCursor, "0|") {
+		nextCursor = ""
+	}
+	return users, nextCursor, nil
+}
+
+// GetUserOwnedLists returns channel with lists owned by a given user.
+func (s *Scraper) GetUserOwnedLists(ctx context.Context, user string, maxListsNbr int) <-chan *ListResult {
+	return getListTimeline(ctx, user, maxListsNbr, s.FetchUserOwnedLists)
+}
+
+// FetchUserOwnedLists gets lists owned by a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserOwnedLists(user string, maxListsNbr int, cursor string) ([]*List, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	variables := map[string]interface{}{
+		"userId":                   userID,
+		"isListMemberTargetUserId": userID,
+	}
+	return s.fetchUserLists("https://x.com/i/api/graphql/Vw6DQ0hf0xLGc7S-Tk4NmA/ListOwnerships", variables, maxListsNbr, cursor)
+}
+
+// GetUserListMemberships returns channel with lists a given user is member of.
+func (s *Scraper) GetUserListMemberships(ctx context.Context, user string, maxListsNbr int) <-chan *ListResult {
+	return getListTimeline(ctx, user, maxListsNbr, s.FetchUserListMemberships)
+}
+
+// FetchUserListMemberships gets lists a given user is member of, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserListMemberships(user string, maxListsNbr int, cursor string) ([]*List, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	variables := map[string]interface{}{
+		"userId": userID,
+	}
+	return s.fetchUserLists("https://x.com/i/api/graphql/BlEXXdARdSeL_0KyKHHvvg/ListMemberships", variables, maxListsNbr, cursor)
+}
+
+// GetUserSubscribedLists returns channel with lists a given user is subscribed to.
+func (s *Scraper) GetUserSubscribedLists(ctx context.Context, user string, maxListsNbr int) <-chan *ListResult {
+	return getListTimeline(ctx, user, maxListsNbr, s.FetchUserSubscribedLists)
+}
+
+// FetchUserSubscribedLists gets lists a given user is subscribed to, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserSubscribedLists(user string, maxListsNbr int, cursor string) ([]*List, string, error) {
+	userID, err := s.GetUserIDByScreenName(user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Combined lists contain both owned and subscribed lists, pages with only
+	// owned lists are skipped to not stop the channel before the end
+	for {
+		variables := map[string]interface{}{
+			"userId": userID,
+		}
+		lists, nextCursor, err := s.fetchUserLists("https://x.com/i/api/graphql/ZBbXrl37E6za5ml-DIpmgg/CombinedLists", variables, maxListsNbr, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var subscribed []*List
+		for _, list := range lists {
+			if list.Owner == nil || list.Owner.UserID != userID {
+				subscribed = append(subscribed, list)
+			}
+		}
+		if len(subscribed) > 0 || nextCursor == "" || nextCursor == cursor || len(lists) == 0 {
+			return subscribed, nextCursor, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func (s *Scraper) fetchUserLists(endpoint string, variables map[string]interface{}, maxListsNbr int, cursor string) ([]*List, string, error) {
+	if maxListsNbr > 100 {
+		maxListsNbr = 100
+	}
+
+	variables["count"] = maxListsNbr
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	var timeline timelineV2
+	err := s.requestList(endpoint, variables, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lists, nextCursor := parseTimelineLists(timeline.Data.User.Result.Timeline.Timeline.Instructions)
+	if strings.HasPrefix(nextCursor, "0|") {
+		nextCursor = ""
+	}
+	return lists, nextCursor, nil
+}
+
+func (s *Scraper) requestList(endpoint string, variables map[string]interface{}, target interface{}) error {
+	req, err := s.newRequest("GET", endpoint)
+	if err != nil {
+		return err
+	}
+
+	features := map[string]interface{}{
+		"rweb_tipjar_consumption_enabled":                                         true,
+		"responsive_web_graphql_exclude_directive_enabled":                        true,
+		"verified_phone_label_enabled":                                            false,
+		"creator_subscriptions_tweet_preview_api_enabled":                         true,
+		"responsive_web_graphql_timeline_navigation_enabled":                      true,
+		"responsive_web_graphql_skip_user_profile_image_extensions_enabled":       false,
+		"communities_web_enable_tweet_community_results_fetch":                    true,
+		"c9s_tweet_anatomy_moderator_badge_enabled":                               true,
+		"articles_preview_enabled":                                                true,
+		"tweetypie_unmention_optimization_enabled":                                true,
+		"responsive_web_edit_tweet_api_enabled":                                   true,
+		"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+		"view_counts_everywhere_api_enabled":                                      true,
+		"longform_notetweets_consumption_enabled":                                 true,
+		"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+		"tweet_awards_web_tipping_enabled":                                        false,
+		"creator_subscriptions_quote_tweet_preview_enabled":                       false,
+		"freedom_of_speech_not_reach_fetch_enabled":                               true,
+		"standardized_nudges_misinfo":                                             true,
+		"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": true,
+		"rweb_video_timestamps_enabled":                                           true,
+		"longform_notetweets_rich_text_read_enabled":                              true,
+		"longform_notetweets_inline_media_enabled":                                true,
+		"responsive_web_enhance_cards_enabled":                                    false,
+	}
+
+	query := url.Values{}
+	query.Set("variables", mapToJSONString(variables))
+	query.Set("features", mapToJSONString(features))
+	req.URL.RawQuery = query.Encode()
+
+	return s.RequestAPI(req, target)
+}
@@ -0,0 +1,59 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLists(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	var listID string
+	for list := range testScraper.GetUserOwnedLists(context.Background(), "XDevelopers", 5) {
+		if list.Error != nil {
+			t.Fatal(list.Error)
+		}
+		if list.ID == "" {
+			t.Error("Expected list ID is empty")
+		}
+		if list.Owner == nil || list.Owner.Username == "" {
+			t.Error("Expected list owner is empty")
+		}
+		if listID == "" && list.MemberCount > 0 {
+			listID = list.ID
+		}
+	}
+	if listID == "" {
+		t.Skip("No lists with members found")
+	}
+
+	list, err := testScraper.GetList(listID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.ID != listID || list.Name == "" {
+		t.Errorf("Expected list %s with name, got %s %q", listID, list.ID, list.Name)
+	}
+
+	members := 0
+	for profile := range testScraper.GetListMembers(context.Background(), listID, 10) {
+		if profile.Error != nil {
+			t.Fatal(profile.Error)
+		}
+		members++
+	}
+	if members == 0 {
+		t.Error("Expected list members")
+	}
+
+	for tweet := range testScraper.GetListTweets(context.Background(), listID, 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.ID == "" {
+			t.Error("Expected tweet ID is empty")
+		}
+	}
+}
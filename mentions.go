@@ -0,0 +1,119 @@
+package twitterscraper
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// maximum pages loaded to catch up with mentions since the saved mark
+const mentionsCatchUpPages = 10
+
+// SetMentionsMark sets ID of the last seen mention, WatchMentions emits only newer mentions.
+// Use it with GetMentionsMark to continue watching after restart.
+func (s *Scraper) SetMentionsMark(tweetID string) *Scraper {
+	s.watchMutex.Lock()
+	defer s.watchMutex.Unlock()
+	s.mentionsMark = tweetID
+	return s
+}
+
+// GetMentionsMark returns ID of the last mention emitted by WatchMentions.
+func (s *Scraper) GetMentionsMark() string {
+	s.watchMutex.Lock()
+	defer s.watchMutex.Unlock()
+	return s.mentionsMark
+}
+
+// WatchMentions returns channel with new mentions of the logged in account, polling every interval.
+// Without mentions mark it starts from the latest mention. Errors are sent to channel and polling continues.
+func (s *Scraper) WatchMentions(ctx context.Context, interval time.Duration) <-chan *TweetResultTimeline {
+	channel := make(chan *TweetResultTimeline)
+	go func() {
+		defer close(channel)
+		var topCursor string
+		skipExisting := s.GetMentionsMark() == ""
+		for {
+			tweets, cursor, err := s.fetchNewMentions(topCursor, skipExisting)
+			if err != nil {
+				select {
+				case channel <- &TweetResultTimeline{Error: err}:
+				case <-ctx.Done():
+					return
+				}
+			} else {
+				skipExisting = false
+				if cursor != "" {
+					topCursor = cursor
+				}
+				for _, tweet := range tweets {
+					select {
+					case channel <- &TweetResultTimeline{Tweet: *tweet}:
+						s.SetMentionsMark(tweet.ID)
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+// fetchNewMentions returns mentions newer than mentions mark, oldest first, and top cursor for the next poll.
+func (s *Scraper) fetchNewMentions(topCursor string, skipExisting bool) ([]*Tweet, string, error) {
+	mark := s.GetMentionsMark()
+
+	var tweets []*Tweet
+	seen := make(map[string]bool)
+	var nextTopCursor string
+	cursor := topCursor
+	for page := 0; page < mentionsCatchUpPages; page++ {
+		timeline, err := s.getNotificationsTimeline(NotificationsMentions, 40, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		notifications, top, bottom := timeline.parseNotifications()
+		if page == 0 {
+			nextTopCursor = top
+		}
+
+		reachedMark := mark == ""
+		for _, notification := range notifications {
+			for _, tweet := range notification.Tweets {
+				if mark != "" && !sortIndexGreater(tweet.ID, mark) {
+					reachedMark = true
+				} else if !seen[tweet.ID] {
+					seen[tweet.ID] = true
+					tweets = append(tweets, tweet)
+				}
+			}
+		}
+
+		// Top cursor returns only newer entries, older pages are loaded once to catch up with the mark
+		if reachedMark || topCursor != "" || bottom == "" || len(notifications) == 0 {
+			break
+		}
+		cursor = bottom
+	}
+
+	sort.Slice(tweets, func(i, j int) bool {
+		return sortIndexGreater(tweets[j].ID, tweets[i].ID)
+	})
+
+	// Without mark start from the latest mention
+	if skipExisting {
+		if len(tweets) > 0 {
+			s.SetMentionsMark(tweets[len(tweets)-1].ID)
+		}
+		return nil, nextTopCursor, nil
+	}
+
+	return tweets, nextTopCursor, nil
+}
@@ -0,0 +1,28 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWatchMentions(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var lastID string
+	for tweet := range testScraper.WatchMentions(ctx, time.Second) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		lastID = tweet.ID
+	}
+
+	if lastID != "" && testScraper.GetMentionsMark() != lastID {
+		t.Errorf("Expected mentions mark %s, got %s", lastID, testScraper.GetMentionsMark())
+	}
+}
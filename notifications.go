@@ -0,0 +1,303 @@
+package twitterscraper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotificationsTab type
+type NotificationsTab int
+
+const (
+	// NotificationsAll - all notifications
+	NotificationsAll NotificationsTab = iota
+	// NotificationsVerified - notifications from verified accounts
+	NotificationsVerified
+	// NotificationsMentions - mentions and replies
+	NotificationsMentions
+)
+
+// NotificationType is kind of notification event.
+type NotificationType string
+
+const (
+	// NotificationLike - someone liked tweet of the account
+	NotificationLike NotificationType = "like"
+	// NotificationRetweet - someone retweeted tweet of the account
+	NotificationRetweet NotificationType = "retweet"
+	// NotificationFollow - someone followed the account
+	NotificationFollow NotificationType = "follow"
+	// NotificationMention - someone mentioned the account
+	NotificationMention NotificationType = "mention"
+	// NotificationReply - someone replied to tweet of the account
+	NotificationReply NotificationType = "reply"
+	// NotificationQuote - someone quoted tweet of the account
+	NotificationQuote NotificationType = "quote"
+	// NotificationOther - any other notification, like recommendations and account alerts
+	NotificationOther NotificationType = "other"
+)
+
+// Notification of the logged in account.
+type Notification struct {
+	ID        string
+	Type      NotificationType
+	Message   string
+	Users     []*Profile
+	Tweets    []*Tweet
+	Timestamp time.Time
+	IsUnread  bool
+	SortIndex string
+}
+
+// NotificationsUnreadCount of the logged in account.
+type NotificationsUnreadCount struct {
+	Notifications int `json:"ntab_unread_count"`
+	DMs           int `json:"dm_unread_count"`
+	Total         int `json:"total_unread_count"`
+}
+
+type legacyNotification struct {
+	ID          string `json:"id"`
+	TimestampMs string `json:"timestampMs"`
+	Icon        struct {
+		ID string `json:"id"`
+	} `json:"icon"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Template struct {
+		AggregateUserActionsV1 struct {
+			TargetObjects []struct {
+				Tweet struct {
+					ID string `json:"id"`
+				} `json:"tweet"`
+			} `json:"targetObjects"`
+			FromUsers []struct {
+				User struct {
+					ID string `json:"id"`
+				} `json:"user"`
+			} `json:"fromUsers"`
+		} `json:"aggregateUserActionsV1"`
+	} `json:"template"`
+}
+
+func (timeline *timelineV1) parseNotifications() ([]*Notification, string, string) {
+	var notifications []*Notification
+	var topCursor, bottomCursor, unreadSortIndex string
+	for _, instruction := range timeline.Timeline.Instructions {
+		if instruction.MarkEntriesUnreadGreaterThanSortIndex.SortIndex != "" {
+			unreadSortIndex = instruction.MarkEntriesUnreadGreaterThanSortIndex.SortIndex
+		}
+		for _, entry := range instruction.AddEntries.Entries {
+			switch entry.Content.Operation.Cursor.CursorType {
+			case "Top":
+				topCursor = entry.Content.Operation.Cursor.Value
+				continue
+			case "Bottom":
+				bottomCursor = entry.Content.Operation.Cursor.Value
+				continue
+			}
+
+			var notification *Notification
+			element := entry.Content.Item.ClientEventInfo.Element
+			if id := entry.Content.Item.Content.Notification.ID; id != "" {
+				notification = timeline.parseNotification(id, element)
+			} else if id := entry.Content.Item.Content.Tweet.ID; id != "" {
+				notification = timeline.parseMention(id, entry.EntryID, element)
+			}
+			if notification == nil {
+				continue
+			}
+			notification.SortIndex = entry.SortIndex
+			notification.IsUnread = sortIndexGreater(entry.SortIndex, unreadSortIndex)
+			notifications = append(notifications, notification)
+		}
+	}
+	return notifications, topCursor, bottomCursor
+}
+
+func (timeline *timelineV1) parseNotification(id string, element string) *Notification {
+	legacy, ok := timeline.GlobalObjects.Notifications[id]
+	if !ok {
+		return nil
+	}
+	notification := &Notification{
+		ID:      id,
+		Type:    parseNotificationType(legacy.Icon.ID, element),
+		Message: legacy.Message.Text,
+	}
+	if ms, err := strconv.ParseInt(legacy.TimestampMs, 10, 64); err == nil {
+		notification.Timestamp = time.Unix(0, ms*int64(time.Millisecond))
+	}
+	for _, from := range legacy.Template.AggregateUserActionsV1.FromUsers {
+		if user, ok := timeline.GlobalObjects.Users[from.User.ID]; ok {
+			profile := parseProfile(user)
+			if profile.UserID == "" {
+				profile.UserID = from.User.ID
+			}
+			notification.Users = append(notification.Users, &profile)
+		}
+	}
+	for _, target := range legacy.Template.AggregateUserActionsV1.TargetObjects {
+		if tweet := timeline.parseTweet(target.Tweet.ID); tweet != nil {
+			notification.Tweets = append(notification.Tweets, tweet)
+		}
+	}
+	return notification
+}
+
+// parseMention returns notification of tweet entry, like mention, reply or quote.
+func (timeline *timelineV1) parseMention(tweetID string, entryID string, element string) *Notification {
+	tweet := timeline.parseTweet(tweetID)
+	if tweet == nil {
+		return nil
+	}
+	notification := &Notification{
+		ID:        strings.TrimPrefix(entryID, "notification-"),
+		Type:      parseNotificationType("", element),
+		Tweets:    []*Tweet{tweet},
+		Timestamp: tweet.TimeParsed,
+	}
+	if notification.Type == NotificationOther {
+		notification.Type = NotificationMention
+	}
+	if tweet.Author != nil {
+		notification.Users = []*Profile{tweet.Author}
+	}
+	return notification
+}
+
+func parseNotificationType(icon string, element string) NotificationType {
+	switch icon {
+	case "heart_icon":
+		return NotificationLike
+	case "retweet_icon":
+		return NotificationRetweet
+	case "person_icon":
+		return NotificationFollow
+	}
+	switch {
+	case strings.Contains(element, "liked"):
+		return NotificationLike
+	case strings.Contains(element, "retweeted"):
+		return NotificationRetweet
+	case strings.Contains(element, "followed"):
+		return NotificationFollow
+	case strings.Contains(element, "replied"):
+		return NotificationReply
+	case strings.Contains(element, "quoted"):
+		return NotificationQuote
+	case strings.Contains(element, "mentioned"):
+		return NotificationMention
+	}
+	return NotificationOther
+}
+
+// sortIndexGreater compares numeric strings like sort indexes of timeline entries or IDs.
+func sortIndexGreater(a, b string) bool {
+	if b == "" {
+		return false
+	}
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	return a > b
+}
+
+// GetNotifications returns channel with notifications of the logged in account.
+func (s *Scraper) GetNotifications(ctx context.Context, tab NotificationsTab, maxNotificationsNbr int) <-chan *NotificationResult {
+	return getNotificationTimeline(ctx, tab, maxNotificationsNbr, s.FetchNotifications)
+}
+
+// FetchNotifications gets notifications of the logged in account, via the Twitter frontend API.
+func (s *Scraper) FetchNotifications(tab NotificationsTab, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	timeline, err := s.getNotificationsTimeline(tab, maxNotificationsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	notifications, _, nextCursor := timeline.parseNotifications()
+	return notifications, nextCursor, nil
+}
+
+func (s *Scraper) getNotificationsTimeline(tab NotificationsTab, maxNotificationsNbr int, cursor string) (*timelineV1, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	if maxNotificationsNbr > 40 {
+		maxNotificationsNbr = 40
+	}
+
+	endpoint := "https://x.com/i/api/2/notifications/all.json"
+	switch tab {
+	case NotificationsVerified:
+		endpoint = "https://x.com/i/api/2/notifications/verified.json"
+	case NotificationsMentions:
+		endpoint = "https://x.com/i/api/2/notifications/mentions.json"
+	}
+
+	req, err := s.newRequest("GET", endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Set("count", strconv.Itoa(maxNotificationsNbr))
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	var timeline timelineV1
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, err
+	}
+	return &timeline, nil
+}
+
+// GetNotificationsUnreadCount returns number of unread notifications and direct messages.
+func (s *Scraper) GetNotificationsUnreadCount() (NotificationsUnreadCount, error) {
+	var count NotificationsUnreadCount
+	req, err := s.newRequest("GET", "https://x.com/i/api/2/badge_count/badge_count.json")
+	if err != nil {
+		return count, err
+	}
+
+	q := req.URL.Query()
+	q.Set("supports_ntab_urt", "1")
+	req.URL.RawQuery = q.Encode()
+
+	err = s.RequestAPI(req, &count)
+	return count, err
+}
+
+// MarkNotificationsRead marks all notifications of the logged in account as read.
+func (s *Scraper) MarkNotificationsRead() error {
+	timeline, err := s.getNotificationsTimeline(NotificationsAll, 1, "")
+	if err != nil {
+		return err
+	}
+	_, topCursor, _ := timeline.parseNotifications()
+	if topCursor == "" {
+		return errors.New("notifications cursor not found")
+	}
+
+	req, err := s.newRequest("POST", "https://x.com/i/api/2/notifications/all/last_seen_cursor.json")
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("cursor", topCursor)
+	req.URL.RawQuery = ""
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Body = io.NopCloser(strings.NewReader(form.Encode()))
+
+	return s.RequestAPI(req, nil)
+}
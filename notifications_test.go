@@ -0,0 +1,55 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+
+	twitterscraper "github.com/0x090909/twitter-go"
+)
+
+func TestGetNotifications(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	for notification := range testScraper.GetNotifications(context.Background(), twitterscraper.NotificationsAll, 20) {
+		if notification.Error != nil {
+			t.Fatal(notification.Error)
+		}
+		if notification.ID == "" {
+			t.Error("Expected notification ID is empty")
+		}
+		if notification.Type == "" {
+			t.Error("Expected notification Type is empty")
+		}
+	}
+}
+
+func TestGetMentionNotifications(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	for notification := range testScraper.GetNotifications(context.Background(), twitterscraper.NotificationsMentions, 20) {
+		if notification.Error != nil {
+			t.Fatal(notification.Error)
+		}
+		if len(notification.Tweets) != 1 {
+			t.Errorf("Expected mention notification %s has tweet", notification.ID)
+		}
+	}
+}
+
+func TestGetNotificationsUnreadCount(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	count, err := testScraper.GetNotificationsUnreadCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.Total < count.Notifications {
+		t.Errorf("Expected total unread count %d includes notifications %d", count.Total, count.Notifications)
+	}
+}
@@ -1,6 +1,7 @@
 package twitterscraper
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -233,6 +234,117 @@ func (s *Scraper) GetProfileByID(userID string) (Profile, error) {
 	return profile, nil
 }
 
+// users per UsersByRestIds and UsersByScreenNames request
+const profilesChunkSize = 100
+
+type users struct {
+	Data struct {
+		Users []struct {
+			Result struct {
+				Typename       string     `json:"__typename"`
+				RestID         string     `json:"rest_id"`
+				Legacy         legacyUser `json:"legacy"`
+				Message        string     `json:"message"`
+				Reason         string     `json:"reason"`
+				IsBlueVerified bool       `json:"is_blue_verified"`
+			} `json:"result"`
+		} `json:"users"`
+	} `json:"data"`
+}
+
+// GetProfilesByIDs return parsed user profiles by user IDs, fetched in batches.
+// Users which failed to load have Error set in their result.
+func (s *Scraper) GetProfilesByIDs(ctx context.Context, userIDs []string) (map[string]*ProfileResult, error) {
+	return s.getProfiles(ctx, "https://x.com/i/api/graphql/itEhGywpgX9b3GJCzOtSrA/UsersByRestIds", "userIds", userIDs,
+		func(profile *Profile) string { return profile.UserID })
+}
+
+// GetProfilesByScreenNames return parsed user profiles by screen names, fetched in batches.
+// Users which failed to load have Error set in their result.
+func (s *Scraper) GetProfilesByScreenNames(ctx context.Context, screenNames []string) (map[string]*ProfileResult, error) {
+	return s.getProfiles(ctx, "https://x.com/i/api/graphql/sAoOMD-9jbs-fmSdEjsKmQ/UsersByScreenNames", "screen_names", screenNames,
+		func(profile *Profile) string { return strings.ToLower(profile.Username) })
+}
+
+func (s *Scraper) getProfiles(ctx context.Context, endpoint string, variable string, keys []string, keyOf func(*Profile) string) (map[string]*ProfileResult, error) {
+	results := make(map[string]*ProfileResult, len(keys))
+	for start := 0; start < len(keys); start += profilesChunkSize {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		end := start + profilesChunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		chunk := keys[start:end]
+
+		req, err := http.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			return results, err
+		}
+
+		variables := map[string]interface{}{
+			variable:                   chunk,
+			"withSafetyModeUserFields": true,
+		}
+
+		features := map[string]interface{}{
+			"hidden_profile_subscriptions_enabled":                              true,
+			"rweb_tipjar_consumption_enabled":                                   true,
+			"responsive_web_graphql_exclude_directive_enabled":                  true,
+			"verified_phone_label_enabled":                                      false,
+			"highlights_tweets_tab_ui_enabled":                                  true,
+			"responsive_web_twitter_article_notes_tab_enabled":                  true,
+			"subscriptions_feature_can_gift_premium":                            true,
+			"creator_subscriptions_tweet_preview_api_enabled":                   true,
+			"responsive_web_graphql_skip_user_profile_image_extensions_enabled": false,
+			"responsive_web_graphql_timeline_navigation_enabled":                true,
+		}
+
+		query := url.Values{}
+		query.Set("variables", mapToJSONString(variables))
+		query.Set("features", mapToJSONString(features))
+		req.URL.RawQuery = query.Encode()
+
+		var jsn users
+		err = s.RequestAPI(req, &jsn)
+		if err != nil {
+			return results, err
+		}
+
+		found := make(map[string]*ProfileResult)
+		for i, user := range jsn.Data.Users {
+			result := user.Result
+			if result.RestID == "" || result.Legacy.ScreenName == "" {
+				// Unavailable users have no ID, results are in the same order as requested
+				if i < len(chunk) && len(jsn.Data.Users) == len(chunk) {
+					if result.Message == "User is suspended" || result.Reason == "Suspended" {
+						found[strings.ToLower(chunk[i])] = &ProfileResult{Error: fmt.Errorf("user is suspended")}
+					} else if result.Message != "" {
+						found[strings.ToLower(chunk[i])] = &ProfileResult{Error: fmt.Errorf("%s", result.Message)}
+					}
+				}
+				continue
+			}
+			result.Legacy.IDStr = result.RestID
+			profile := parseProfile(result.Legacy)
+			profile.IsBlueVerified = result.IsBlueVerified
+			cacheIDs.Store(profile.Username, profile.UserID)
+			found[keyOf(&profile)] = &ProfileResult{Profile: profile}
+		}
+
+		for _, key := range chunk {
+			if result, ok := found[strings.ToLower(key)]; ok {
+				results[key] = result
+			} else {
+				results[key] = &ProfileResult{Error: fmt.Errorf("user not found")}
+			}
+		}
+	}
+	return results, nil
+}
+
 // GetUserIDByScreenName from API
 func (s *Scraper) GetUserIDByScreenName(screenName string) (string, error) {
 	id, ok := cacheIDs.Load(screenName)
@@ -1,6 +1,7 @@
 package twitterscraper_test
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -158,3 +159,31 @@ func TestGetUserIDByScreenName(t *testing.T) {
 		t.Error("Expected non-empty user ID")
 	}
 }
+
+func TestGetProfilesByIDs(t *testing.T) {
+	profiles, err := testScraper.GetProfilesByIDs(context.Background(), []string{"1221221876849995777", "783214"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile := profiles["1221221876849995777"]; profile.Error != nil || profile.Username != "tomdumont" {
+		t.Errorf("Expected username 'tomdumont', got '%s' (%v)", profile.Username, profile.Error)
+	}
+	if profile := profiles["783214"]; profile.Error != nil || profile.UserID != "783214" {
+		t.Errorf("Expected user ID '783214', got '%s' (%v)", profile.UserID, profile.Error)
+	}
+}
+
+func TestGetProfilesByScreenNames(t *testing.T) {
+	profiles, err := testScraper.GetProfilesByScreenNames(context.Background(), []string{"X", "sample3123131"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile := profiles["X"]; profile.Error != nil || profile.UserID != "783214" {
+		t.Errorf("Expected user ID '783214', got '%s' (%v)", profile.UserID, profile.Error)
+	}
+	if profile := profiles["sample3123131"]; profile.Error == nil {
+		t.Error("Expected Error for not existing user, got success")
+	}
+}
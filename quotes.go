@@ -0,0 +1,18 @@
+package twitterscraper
+
+import "context"
+
+// GetTweetQuotes returns channel with tweets quoting the tweet, newest first.
+func (s *Scraper) GetTweetQuotes(ctx context.Context, id string, maxTweetsNbr int) <-chan *TweetResultTimeline {
+	return getTweetTimeline(ctx, id, maxTweetsNbr, s.FetchTweetQuotes)
+}
+
+// FetchTweetQuotes gets tweets quoting the tweet, via the Twitter frontend API
+func (s *Scraper) FetchTweetQuotes(id string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	timeline, err := s.getSearchTimeline("quoted_tweet_id:"+id, SearchLatest, maxTweetsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	tweets, nextCursor := timeline.parseTweets()
+	return tweets, nextCursor, nil
+}
@@ -0,0 +1,28 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetTweetQuotes(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	tweetID := "1328684389388185600"
+	count := 0
+	for tweet := range testScraper.GetTweetQuotes(context.Background(), tweetID, 20) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		count++
+		if tweet.QuotedStatusID != tweetID {
+			t.Errorf("Expected tweet %s quotes %s, got %s", tweet.ID, tweetID, tweet.QuotedStatusID)
+		}
+	}
+
+	if count == 0 {
+		t.Error("Expected quote tweets")
+	}
+}
@@ -60,7 +60,7 @@ func (s *Scraper) GetTweetReplies(id string, cursor string) ([]*Tweet, []*Thread
 
 	fieldToggles := map[string]interface{}{
 		"withArticleRichContentState": true,
-		"withArticlePlainText":        false,
+		"withArticlePlainText":        true,
 		"withGrokAnalyze":             false,
 		"withDisallowedReplyControls": false,
 	}
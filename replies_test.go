@@ -2,6 +2,8 @@ package twitterscraper_test
 
 import (
 	"testing"
+
+	twitterscraper "github.com/0x090909/twitter-go"
 )
 
 func TestGetReplies(t *testing.T) {
@@ -25,3 +27,33 @@ func TestGetReplies(t *testing.T) {
 	}
 }
 
+func TestGetRepliesUnavailable(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	// deleted by author, conversation returns it as tombstone
+	tweetID := "869766994899468288"
+
+	tweets, _, err := testScraper.GetTweetReplies(tweetID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+	for _, tweet := range tweets {
+		if tweet.ID != tweetID {
+			continue
+		}
+		found = true
+		if tweet.Unavailable != twitterscraper.UnavailableDeleted {
+			t.Errorf("Expected unavailable reason %s, got %q", twitterscraper.UnavailableDeleted, tweet.Unavailable)
+		}
+		if tweet.PermanentURL == "" {
+			t.Error("Expected unavailable tweet has PermanentURL")
+		}
+	}
+	if !found {
+		t.Fatalf("Expected deleted tweet %s is returned as unavailable", tweetID)
+	}
+}
@@ -64,12 +64,18 @@ func (result *scheduleTweet) parse() *ScheduledTweet {
 
 		if media.MediaInfo.Typename == "ApiVideo" {
 			video := Video{
-				ID:      key,
-				Preview: media.MediaInfo.PreviewImage.OriginalImgURL,
+				ID:          key,
+				Preview:     media.MediaInfo.PreviewImage.OriginalImgURL,
+				MediaKey:    media.MediaKey,
+				Width:       media.MediaInfo.PreviewImage.OriginalImgWidth,
+				Height:      media.MediaInfo.PreviewImage.OriginalImgHeight,
+				AspectRatio: [2]int{media.MediaInfo.AspectRatio.Numerator, media.MediaInfo.AspectRatio.Denominator},
+				Duration:    time.Duration(media.MediaInfo.DurationMillis) * time.Millisecond,
 			}
 
 			maxBitrate := 0
 			for _, variant := range media.MediaInfo.Variants {
+				video.Variants = append(video.Variants, newVideoVariant(variant.ContentType, variant.Bitrate, variant.URL))
 				if variant.Bitrate > maxBitrate {
 					video.URL = strings.TrimSuffix(variant.URL, "?tag=10")
 					maxBitrate = variant.Bitrate
@@ -79,12 +85,17 @@ func (result *scheduleTweet) parse() *ScheduledTweet {
 			tweet.Videos = append(tweet.Videos, video)
 		} else if media.MediaInfo.Typename == "ApiGif" {
 			gif := GIF{
-				ID:      key,
-				Preview: media.MediaInfo.PreviewImage.OriginalImgURL,
+				ID:          key,
+				Preview:     media.MediaInfo.PreviewImage.OriginalImgURL,
+				MediaKey:    media.MediaKey,
+				Width:       media.MediaInfo.PreviewImage.OriginalImgWidth,
+				Height:      media.MediaInfo.PreviewImage.OriginalImgHeight,
+				AspectRatio: [2]int{media.MediaInfo.AspectRatio.Numerator, media.MediaInfo.AspectRatio.Denominator},
 			}
 
 			maxBitrate := 0
 			for _, variant := range media.MediaInfo.Variants {
+				gif.Variants = append(gif.Variants, newVideoVariant(variant.ContentType, variant.Bitrate, variant.URL))
 				if variant.Bitrate >= maxBitrate {
 					gif.URL = variant.URL
 					maxBitrate = variant.Bitrate
@@ -93,8 +104,11 @@ func (result *scheduleTweet) parse() *ScheduledTweet {
 			tweet.GIFs = append(tweet.GIFs, gif)
 		} else if media.MediaInfo.Typename == "ApiImage" {
 			tweet.Photos = append(tweet.Photos, Photo{
-				ID:  key,
-				URL: media.MediaInfo.OriginalImgURL,
+				ID:       key,
+				URL:      media.MediaInfo.OriginalImgURL,
+				MediaKey: media.MediaKey,
+				Width:    media.MediaInfo.OriginalImgWidth,
+				Height:   media.MediaInfo.OriginalImgHeight,
 			})
 		}
 	}
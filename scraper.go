@@ -30,6 +30,11 @@ type Scraper struct {
 	userAgent      string
 	searchMode     SearchMode
 	wg             sync.WaitGroup
+	watchMutex     sync.Mutex
+	mentionsMark   string
+	dmCursor       string
+	rateLimitMutex sync.Mutex
+	rateLimits     map[string]rateLimit
 }
 
 // SearchMode type
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"net/url"
-	"strconv"
 )
 
 const searchURL = "https://x.com/i/api/graphql/nK1dw4oV3k4w5TdtcAdSww/SearchTimeline"
@@ -36,20 +35,7 @@ func (timeline *searchTimeline) parseTweets() ([]*Tweet, string) {
 			}
 			for _, entry := range instruction.Entries {
 				if entry.Content.ItemContent.TweetDisplayType == "Tweet" {
-					var legacy *legacyTweet = &entry.Content.ItemContent.TweetResults.Result.Legacy
-					var user *legacyUser = &entry.Content.ItemContent.TweetResults.Result.Core.UserResults.Result.Legacy
-					if entry.Content.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-						legacy = &entry.Content.ItemContent.TweetResults.Result.Tweet.Legacy
-						user = &entry.Content.ItemContent.TweetResults.Result.Tweet.Core.UserResults.Result.Legacy
-					}
-					if tweet := parseLegacyTweet(user, legacy); tweet != nil {
-						var views = entry.Content.ItemContent.TweetResults.Result.Views.Count
-						if entry.Content.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-							views = entry.Content.ItemContent.TweetResults.Result.Tweet.Views.Count
-						}
-						if tweet.Views == 0 && views != "" {
-							tweet.Views, _ = strconv.Atoi(views)
-						}
+					if tweet := entry.Content.ItemContent.TweetResults.Result.parse(); tweet != nil {
 						tweets = append(tweets, tweet)
 					}
 				} else if entry.Content.CursorType == "Bottom" {
@@ -98,7 +84,7 @@ func (s *Scraper) SearchProfiles(ctx context.Context, query string, maxProfilesN
 }
 
 // getSearchTimeline gets results for a given search query, via the Twitter frontend API
-func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*searchTimeline, error) {
+func (s *Scraper) getSearchTimeline(query string, mode SearchMode, maxNbr int, cursor string) (*searchTimeline, error) {
 	if !s.isLogged {
 		return nil, errors.New("scraper is not logged in for search")
 	}
@@ -149,7 +135,7 @@ func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*s
 	if cursor != "" {
 		variables["cursor"] = cursor
 	}
-	switch s.searchMode {
+	switch mode {
 	case SearchLatest:
 		variables["product"] = "Latest"
 	case SearchPhotos:
@@ -176,7 +162,7 @@ func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*s
 
 // FetchSearchTweets gets tweets for a given search query, via the Twitter frontend API
 func (s *Scraper) FetchSearchTweets(query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
-	timeline, err := s.getSearchTimeline(query, maxTweetsNbr, cursor)
+	timeline, err := s.getSearchTimeline(query, s.searchMode, maxTweetsNbr, cursor)
 	if err != nil {
 		return nil, "", err
 	}
@@ -186,7 +172,7 @@ func (s *Scraper) FetchSearchTweets(query string, maxTweetsNbr int, cursor strin
 
 // FetchSearchProfiles gets users for a given search query, via the Twitter frontend API
 func (s *Scraper) FetchSearchProfiles(query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
-	timeline, err := s.getSearchTimeline(query, maxProfilesNbr, cursor)
+	timeline, err := s.getSearchTimeline(query, s.searchMode, maxProfilesNbr, cursor)
 	if err != nil {
 		return nil, "", err
 	}
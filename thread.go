@@ -0,0 +1,71 @@
+package twitterscraper
+
+import "context"
+
+// GetThread returns complete self-thread of tweet author ordered from the first tweet.
+// Thread field of the first tweet is populated with the rest of the thread.
+func (s *Scraper) GetThread(ctx context.Context, tweetID string) ([]*Tweet, error) {
+	tweet, err := s.GetTweet(tweetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk up to the first tweet of author
+	root := tweet
+	for root.InReplyToStatusID != "" {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		parent := root.InReplyToStatus
+		if parent == nil {
+			parent, err = s.GetTweet(root.InReplyToStatusID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if parent.UserID != tweet.UserID {
+			break
+		}
+		root = parent
+	}
+
+	// Walk down through author replies
+	thread := []*Tweet{root}
+	seen := map[string]bool{root.ID: true}
+	last := root
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		replies, _, err := s.GetTweetReplies(last.ID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		next := last
+		for found := true; found; {
+			found = false
+			for _, reply := range replies {
+				if reply.InReplyToStatusID == next.ID && reply.UserID == root.UserID && !seen[reply.ID] {
+					seen[reply.ID] = true
+					thread = append(thread, reply)
+					next = reply
+					found = true
+					break
+				}
+			}
+		}
+		if next == last {
+			break
+		}
+		last = next
+	}
+
+	root.Thread = thread[1:]
+	root.IsSelfThread = len(root.Thread) > 0
+	for _, tweet := range root.Thread {
+		tweet.IsSelfThread = true
+	}
+
+	return thread, nil
+}
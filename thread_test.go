@@ -0,0 +1,33 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetThread(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	thread, err := testScraper.GetThread(context.Background(), "1665602315745673217")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(thread) != 8 {
+		t.Fatalf("Expected thread of 8 tweets, got %d", len(thread))
+	}
+	root := thread[0]
+	if len(root.Thread) != len(thread)-1 {
+		t.Errorf("Expected root Thread has %d tweets, got %d", len(thread)-1, len(root.Thread))
+	}
+	for i, tweet := range thread[1:] {
+		if tweet.UserID != root.UserID {
+			t.Errorf("Expected thread tweet %s by thread author", tweet.ID)
+		}
+		if tweet.InReplyToStatusID != thread[i].ID {
+			t.Errorf("Expected tweet %s replies to %s", tweet.ID, thread[i].ID)
+		}
+	}
+}
@@ -10,14 +10,17 @@ import (
 // legacy timeline JSON object
 type timelineV1 struct {
 	GlobalObjects struct {
-		Tweets map[string]legacyTweet `json:"tweets"`
-		Users  map[string]legacyUser  `json:"users"`
+		Tweets        map[string]legacyTweet        `json:"tweets"`
+		Users         map[string]legacyUser         `json:"users"`
+		Notifications map[string]legacyNotification `json:"notifications"`
 	} `json:"globalObjects"`
 	Timeline struct {
 		Instructions []struct {
 			AddEntries struct {
 				Entries []struct {
-					Content struct {
+					EntryID   string `json:"entryId"`
+					SortIndex string `json:"sortIndex"`
+					Content   struct {
 						Item struct {
 							Content struct {
 								Tweet struct {
@@ -26,7 +29,13 @@ type timelineV1 struct {
 								User struct {
 									ID string `json:"id"`
 								} `json:"user"`
+								Notification struct {
+									ID string `json:"id"`
+								} `json:"notification"`
 							} `json:"content"`
+							ClientEventInfo struct {
+								Element string `json:"element"`
+							} `json:"clientEventInfo"`
 						} `json:"item"`
 						Operation struct {
 							Cursor struct {
@@ -79,6 +88,9 @@ type timelineV1 struct {
 					} `json:"content"`
 				} `json:"entry"`
 			} `json:"replaceEntry,omitempty"`
+			MarkEntriesUnreadGreaterThanSortIndex struct {
+				SortIndex string `json:"sortIndex"`
+			} `json:"markEntriesUnreadGreaterThanSortIndex,omitempty"`
 		} `json:"instructions"`
 	} `json:"timeline"`
 }
@@ -100,16 +112,29 @@ func (timeline *timelineV1) parseTweet(id string) *Tweet {
 			Username:       username,
 		}
 
+		parseTweetMetadata(tw, &tweet)
+
 		tm, err := time.Parse(time.RubyDate, tweet.CreatedAt)
 		if err == nil {
 			tw.TimeParsed = tm
 			tw.Timestamp = tm.Unix()
 		}
 
+		if user, ok := timeline.GlobalObjects.Users[tweet.UserIDStr]; ok {
+			author := parseProfile(user)
+			if author.UserID == "" {
+				author.UserID = tweet.UserIDStr
+			}
+			tw.Author = &author
+		}
+
 		if tweet.Place.ID != "" {
 			tw.Place = &tweet.Place
 		}
 
+		tw.Poll = parsePoll(tweet.Card.Name, tweet.Card.BindingValues)
+		tw.Card = parseCard(tweet.Card.Name, tweet.Card.URL, tweet.Card.BindingValues, tweet.Entities.URLs)
+
 		if tweet.QuotedStatusIDStr != "" {
 			tw.IsQuoted = true
 			tw.QuotedStatus = timeline.parseTweet(tweet.QuotedStatusIDStr)
@@ -158,28 +183,13 @@ func (timeline *timelineV1) parseTweet(id string) *Tweet {
 		}
 
 		for _, media := range tweet.ExtendedEntities.Media {
-			if media.Type == "photo" {
-				photo := Photo{
-					ID:  media.IDStr,
-					URL: media.MediaURLHttps,
-				}
-
-				tw.Photos = append(tw.Photos, photo)
-			} else if media.Type == "video" {
-				video := Video{
-					ID:      media.IDStr,
-					Preview: media.MediaURLHttps,
-				}
-
-				maxBitrate := 0
-				for _, variant := range media.VideoInfo.Variants {
-					if variant.Bitrate > maxBitrate {
-						video.URL = strings.TrimSuffix(variant.URL, "?tag=10")
-						maxBitrate = variant.Bitrate
-					}
-				}
-
-				tw.Videos = append(tw.Videos, video)
+			photo, video, gif := parseExtendedMedia(media)
+			if photo != nil {
+				tw.Photos = append(tw.Photos, *photo)
+			} else if video != nil {
+				tw.Videos = append(tw.Videos, *video)
+			} else if gif != nil {
+				tw.GIFs = append(tw.GIFs, *gif)
 			}
 
 			if !tw.SensitiveContent {
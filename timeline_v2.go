@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type tweet struct {
@@ -18,34 +19,59 @@ type tweet struct {
 	Views struct {
 		Count string `json:"count"`
 	} `json:"views"`
-	NoteTweet struct {
+	EditControl EditControl `json:"edit_control"`
+	Source      string      `json:"source"`
+	NoteTweet   struct {
 		NoteTweetResults struct {
 			Result struct {
-				Text string `json:"text"`
+				Text      string `json:"text"`
+				EntitySet struct {
+					Hashtags []struct {
+						Text string `json:"text"`
+					} `json:"hashtags"`
+					URLs         []Url `json:"urls"`
+					UserMentions []struct {
+						IDStr      string `json:"id_str"`
+						Name       string `json:"name"`
+						ScreenName string `json:"screen_name"`
+					} `json:"user_mentions"`
+				} `json:"entity_set"`
+				Richtext struct {
+					RichtextTags []struct {
+						FromIndex     int      `json:"from_index"`
+						ToIndex       int      `json:"to_index"`
+						RichtextTypes []string `json:"richtext_types"`
+					} `json:"richtext_tags"`
+				} `json:"richtext"`
+				Media struct {
+					InlineMedia []struct {
+						MediaID string `json:"media_id"`
+						Index   int    `json:"index"`
+					} `json:"inline_media"`
+				} `json:"media"`
 			} `json:"result"`
 		} `json:"note_tweet_results"`
 	} `json:"note_tweet"`
+	Article            article `json:"article"`
 	QuotedStatusResult struct {
 		Result *result `json:"result"`
 	} `json:"quoted_status_result"`
-	Legacy legacyTweet `json:"legacy"`
-	Card   struct {
-		RestID string `json:"rest_id"`
-		Legacy struct {
-			BindingValues []struct {
-				Key   string `json:"key"`
-				Value struct {
-					StringValue string `json:"string_value"`
-				} `json:"value"`
-			} `json:"binding_values"`
-		} `json:"legacy"`
-	} `json:"card"`
+	Legacy         legacyTweet    `json:"legacy"`
+	Card           card           `json:"card"`
+	BirdwatchPivot birdwatchPivot `json:"birdwatch_pivot"`
 }
 
 type result struct {
 	Typename string `json:"__typename"`
 	tweet
-	Tweet tweet `json:"tweet"`
+	Tweet                tweet `json:"tweet"`
+	LimitedActionResults struct {
+		LimitedActions []struct {
+			Action string `json:"action"`
+		} `json:"limited_actions"`
+	} `json:"limitedActionResults"`
+	Tombstone tombstone `json:"tombstone"`
+	Reason    string    `json:"reason"`
 }
 
 type UnifiedCard struct {
@@ -74,28 +100,81 @@ type UnifiedCard struct {
 }
 
 func (result *result) parse() *Tweet {
-	if result.NoteTweet.NoteTweetResults.Result.Text != "" {
-		result.Legacy.FullText = result.NoteTweet.NoteTweetResults.Result.Text
-	}
-	var legacy *legacyTweet = &result.Legacy
-	var user *legacyUser = &result.Core.UserResults.Result.Legacy
+	var tweet = &result.tweet
 	if result.Typename == "TweetWithVisibilityResults" {
-		legacy = &result.Tweet.Legacy
-		user = &result.Tweet.Core.UserResults.Result.Legacy
+		tweet = &result.Tweet
+	}
+	var note = &tweet.NoteTweet.NoteTweetResults.Result
+	if note.Text != "" {
+		// Legacy entities are limited to the first 280 characters of note tweet
+		tweet.Legacy.FullText = note.Text
+		tweet.Legacy.Entities.Hashtags = note.EntitySet.Hashtags
+		tweet.Legacy.Entities.URLs = note.EntitySet.URLs
+		tweet.Legacy.Entities.UserMentions = note.EntitySet.UserMentions
 	}
-	tw := parseLegacyTweet(user, legacy)
+	var legacy *legacyTweet = &tweet.Legacy
+	var card *card = &tweet.Card
+	var editControl *EditControl = &tweet.EditControl
+	tw := parseLegacyTweet(&tweet.Core.UserResults.Result.Legacy, legacy)
 	if tw == nil {
 		return nil
 	}
-	if tw.Views == 0 && result.Views.Count != "" {
-		tw.Views, _ = strconv.Atoi(result.Views.Count)
+	if tw.Author != nil {
+		tw.Author.IsBlueVerified = tweet.Core.UserResults.Result.IsBlueVerified
+	}
+	if tw.Views == 0 && tweet.Views.Count != "" {
+		tw.Views, _ = strconv.Atoi(tweet.Views.Count)
+	}
+	if tweet.Source != "" {
+		tw.Source = parseSource(tweet.Source)
+	}
+	// GraphQL limited actions replace the legacy field, which is used only as fallback
+	if actions := result.LimitedActionResults.LimitedActions; len(actions) > 0 {
+		tw.LimitedActions = nil
+		for _, action := range actions {
+			tw.LimitedActions = append(tw.LimitedActions, action.Action)
+		}
+	}
+	if tweet.QuotedStatusResult.Result != nil {
+		tw.QuotedStatus = tweet.QuotedStatusResult.Result.parse()
+	}
+	tw.CommunityNote = tweet.BirdwatchPivot.parse()
+	tw.Article = tweet.Article.parse()
+
+	for _, tag := range note.Richtext.RichtextTags {
+		tw.RichTextTags = append(tw.RichTextTags, RichTextTag{
+			FromIndex: tag.FromIndex,
+			ToIndex:   tag.ToIndex,
+			Types:     tag.RichtextTypes,
+		})
+	}
+	for _, media := range note.Media.InlineMedia {
+		tw.InlineMedia = append(tw.InlineMedia, InlineMedia{
+			MediaID: media.MediaID,
+			Index:   media.Index,
+		})
 	}
-	if result.QuotedStatusResult.Result != nil {
-		tw.QuotedStatus = result.QuotedStatusResult.Result.parse()
+
+	// Older versions of edited tweet keep edit history in edit_control_initial
+	if editControl.EditControlInitial != nil {
+		editControl = editControl.EditControlInitial
+	}
+	tw.EditHistoryIDs = editControl.EditTweetIds
+	tw.IsEdited = len(editControl.EditTweetIds) > 1
+	tw.EditsRemaining, _ = strconv.Atoi(editControl.EditsRemaining)
+	if ms, err := strconv.ParseInt(editControl.EditableUntilMsecs, 10, 64); err == nil {
+		tw.EditableUntil = time.Unix(0, ms*int64(time.Millisecond))
+	}
+
+	if values := card.bindingValues(); len(values) > 0 {
+		if poll := parsePoll(card.Legacy.Name, values); poll != nil {
+			tw.Poll = poll
+		}
+		tw.Card = parseCard(card.Legacy.Name, card.Legacy.URL, values, legacy.Entities.URLs)
 	}
 
 	// Get videos from cards
-	for _, v := range result.Tweet.Card.Legacy.BindingValues {
+	for _, v := range card.Legacy.BindingValues {
 		if v.Key == "unified_card" {
 			var card UnifiedCard
 			err := json.Unmarshal([]byte(v.Value.StringValue), &card)
@@ -109,9 +188,14 @@ func (result *result) parse() *Tweet {
 
 					vid.ID = media.IDStr
 					vid.Preview = media.MediaURLHTTPS
+					vid.Width = media.OriginalInfo.Width
+					vid.Height = media.OriginalInfo.Height
+					vid.AspectRatio = parseAspectRatio(media.VideoInfo.AspectRatio)
+					vid.Duration = time.Duration(media.VideoInfo.DurationMillis) * time.Millisecond
 
 					var bitrate int
 					for _, variant := range media.VideoInfo.Variants {
+						vid.Variants = append(vid.Variants, newVideoVariant(variant.ContentType, variant.Bitrate, variant.URL))
 						if variant.ContentType == "video/mp4" {
 							if variant.Bitrate > bitrate {
 								bitrate = variant.Bitrate
@@ -164,14 +248,17 @@ type item struct {
 			TweetResults     struct {
 				Result result `json:"result"`
 			} `json:"tweet_results"`
-			CursorType string `json:"cursorType"`
-			Value      string `json:"value"`
+			List       listResult `json:"list"`
+			CursorType string     `json:"cursorType"`
+			Value      string     `json:"value"`
 		} `json:"itemContent"`
 	} `json:"item"`
 }
 
 type entry struct {
-	Content struct {
+	EntryID   string `json:"entryId"`
+	SortIndex string `json:"sortIndex"`
+	Content   struct {
 		CursorType  string `json:"cursorType"`
 		Value       string `json:"value"`
 		Items       []item `json:"items"`
@@ -185,12 +272,20 @@ type entry struct {
 			UserResults     struct {
 				Result userResult `json:"result"`
 			} `json:"user_results"`
-			CursorType string `json:"cursorType"`
-			Value      string `json:"value"`
+			List       listResult `json:"list"`
+			CursorType string     `json:"cursorType"`
+			Value      string     `json:"value"`
 		} `json:"itemContent"`
 	} `json:"content"`
 }
 
+type instruction struct {
+	ModuleItems []item  `json:"moduleItems"`
+	Entries     []entry `json:"entries"`
+	Entry       entry   `json:"entry"`
+	Type        string  `json:"type"`
+}
+
 // timeline v2 JSON object
 type timelineV2 struct {
 	Data struct {
@@ -198,22 +293,13 @@ type timelineV2 struct {
 			Result struct {
 				TimelineV2 struct {
 					Timeline struct {
-						Instructions []struct {
-							ModuleItems []item  `json:"moduleItems"`
-							Entries     []entry `json:"entries"`
-							Entry       entry   `json:"entry"`
-							Type        string  `json:"type"`
-						} `json:"instructions"`
+						Instructions []instruction `json:"instructions"`
 					} `json:"timeline"`
 				} `json:"timeline_v2"`
 
 				Timeline struct {
 					Timeline struct {
-						Instructions []struct {
-							Entries []entry `json:"entries"`
-							Entry   entry   `json:"entry"`
-							Type    string  `json:"type"`
-						} `json:"instructions"`
+						Instructions []instruction `json:"instructions"`
 					} `json:"timeline"`
 				} `json:"timeline"`
 			} `json:"result"`
@@ -221,23 +307,33 @@ type timelineV2 struct {
 	} `json:"data"`
 }
 
+// instructions returns instructions of both timeline_v2 and timeline, newer user
+// timelines like highlights and articles are returned in timeline instead of timeline_v2.
+func (timeline *timelineV2) instructions() []instruction {
+	var instructions []instruction
+	instructions = append(instructions, timeline.Data.User.Result.TimelineV2.Timeline.Instructions...)
+	return append(instructions, timeline.Data.User.Result.Timeline.Timeline.Instructions...)
+}
+
 func (timeline *timelineV2) parseTweets() ([]*Tweet, string) {
+	return parseTimelineTweets(timeline.instructions())
+}
+
+func parseTimelineTweets(instructions []instruction) ([]*Tweet, string) {
 	var cursor string
 	var tweets []*Tweet
-	for _, instruction := range timeline.Data.User.Result.TimelineV2.Timeline.Instructions {
+	for _, instruction := range instructions {
 		for _, entry := range instruction.Entries {
 			if entry.Content.CursorType == "Bottom" {
 				cursor = entry.Content.Value
 				continue
 			}
-			if entry.Content.ItemContent.TweetResults.Result.Typename == "Tweet" || entry.Content.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-				if tweet := entry.Content.ItemContent.TweetResults.Result.parse(); tweet != nil {
-					tweets = append(tweets, tweet)
-				}
+			if tweet := entry.Content.ItemContent.TweetResults.Result.parseEntry(entry.EntryID); tweet != nil {
+				tweets = append(tweets, tweet)
 			}
 			if len(entry.Content.Items) > 0 {
 				for _, item := range entry.Content.Items {
-					if tweet := item.Item.ItemContent.TweetResults.Result.parse(); tweet != nil {
+					if tweet := item.Item.ItemContent.TweetResults.Result.parseEntry(item.EntryID); tweet != nil {
 						tweets = append(tweets, tweet)
 					}
 				}
@@ -245,10 +341,8 @@ func (timeline *timelineV2) parseTweets() ([]*Tweet, string) {
 		}
 		if len(instruction.ModuleItems) > 0 {
 			for _, entry := range instruction.ModuleItems {
-				if entry.Item.ItemContent.TweetResults.Result.Typename == "Tweet" || entry.Item.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-					if tweet := entry.Item.ItemContent.TweetResults.Result.parse(); tweet != nil {
-						tweets = append(tweets, tweet)
-					}
+				if tweet := entry.Item.ItemContent.TweetResults.Result.parseEntry(entry.EntryID); tweet != nil {
+					tweets = append(tweets, tweet)
 				}
 			}
 		}
@@ -288,41 +382,34 @@ func (timeline *bookmarksTimelineV2) parseTweets() ([]*Tweet, string) {
 	return tweets, cursor
 }
 
-type retweetersTimelineV2 struct {
+type usersTimeline struct {
+	Timeline struct {
+		Instructions []instruction `json:"instructions"`
+	} `json:"timeline"`
+}
+
+// userListTimelineV2 is timeline of users who retweeted or liked tweet.
+type userListTimelineV2 struct {
 	Data struct {
-		RetweetersTimeline struct {
-			Timeline struct {
-				Instructions []struct {
-					Type    string  `json:"type"`
-					Entries []entry `json:"entries"`
-				} `json:"instructions"`
-			} `json:"timeline"`
-		} `json:"retweeters_timeline"`
+		RetweetersTimeline usersTimeline `json:"retweeters_timeline"`
+		FavoritersTimeline usersTimeline `json:"favoriters_timeline"`
 	} `json:"data"`
 }
 
-func (timeline *retweetersTimelineV2) parseUsers() ([]*Profile, string) {
-	var cursor string
-	var users []*Profile
-	for _, instruction := range timeline.Data.RetweetersTimeline.Timeline.Instructions {
-		for _, entry := range instruction.Entries {
-			if entry.Content.CursorType == "Bottom" {
-				cursor = entry.Content.Value
-				continue
-			}
-			if entry.Content.ItemContent.UserResults.Result.Typename == "User" {
-				user := entry.Content.ItemContent.UserResults.Result.parse()
-				users = append(users, &user)
-			}
-		}
-	}
-	return users, cursor
+func (timeline *userListTimelineV2) parseUsers() ([]*Profile, string) {
+	instructions := timeline.Data.RetweetersTimeline.Timeline.Instructions
+	instructions = append(instructions, timeline.Data.FavoritersTimeline.Timeline.Instructions...)
+	return parseTimelineUsers(instructions)
 }
 
 func (timeline *timelineV2) parseUsers() ([]*Profile, string) {
+	return parseTimelineUsers(timeline.Data.User.Result.Timeline.Timeline.Instructions)
+}
+
+func parseTimelineUsers(instructions []instruction) ([]*Profile, string) {
 	var cursor string
 	var users []*Profile
-	for _, instruction := range timeline.Data.User.Result.Timeline.Timeline.Instructions {
+	for _, instruction := range instructions {
 		for _, entry := range instruction.Entries {
 			if entry.Content.CursorType == "Bottom" {
 				cursor = entry.Content.Value
@@ -355,13 +442,11 @@ func (conversation *threadedConversation) parse(focalTweetID string) ([]*Tweet,
 	var cursors []*ThreadCursor
 	for _, instruction := range conversation.Data.ThreadedConversationWithInjectionsV2.Instructions {
 		for _, entry := range instruction.Entries {
-			if entry.Content.ItemContent.TweetResults.Result.Typename == "Tweet" || entry.Content.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-				if tweet := entry.Content.ItemContent.TweetResults.Result.parse(); tweet != nil {
-					if entry.Content.ItemContent.TweetDisplayType == "SelfThread" {
-						tweet.IsSelfThread = true
-					}
-					tweets = append(tweets, tweet)
+			if tweet := entry.Content.ItemContent.TweetResults.Result.parseEntry(entry.EntryID); tweet != nil {
+				if entry.Content.ItemContent.TweetDisplayType == "SelfThread" {
+					tweet.IsSelfThread = true
 				}
+				tweets = append(tweets, tweet)
 			}
 
 			if entry.Content.ItemContent.CursorType != "" && entry.Content.ItemContent.Value != "" {
@@ -374,13 +459,11 @@ func (conversation *threadedConversation) parse(focalTweetID string) ([]*Tweet,
 			}
 
 			for _, item := range entry.Content.Items {
-				if item.Item.ItemContent.TweetResults.Result.Typename == "Tweet" || item.Item.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-					if tweet := item.Item.ItemContent.TweetResults.Result.parse(); tweet != nil {
-						if item.Item.ItemContent.TweetDisplayType == "SelfThread" {
-							tweet.IsSelfThread = true
-						}
-						tweets = append(tweets, tweet)
+				if tweet := item.Item.ItemContent.TweetResults.Result.parseEntry(item.EntryID); tweet != nil {
+					if item.Item.ItemContent.TweetDisplayType == "SelfThread" {
+						tweet.IsSelfThread = true
 					}
+					tweets = append(tweets, tweet)
 				}
 
 				if item.Item.ItemContent.CursorType != "" && item.Item.ItemContent.Value != "" {
@@ -403,13 +486,11 @@ func (conversation *threadedConversation) parse(focalTweetID string) ([]*Tweet,
 			}
 		}
 		for _, item := range instruction.ModuleItems {
-			if item.Item.ItemContent.TweetResults.Result.Typename == "Tweet" || item.Item.ItemContent.TweetResults.Result.Typename == "TweetWithVisibilityResults" {
-				if tweet := item.Item.ItemContent.TweetResults.Result.parse(); tweet != nil {
-					if item.Item.ItemContent.TweetDisplayType == "SelfThread" {
-						tweet.IsSelfThread = true
-					}
-					tweets = append(tweets, tweet)
+			if tweet := item.Item.ItemContent.TweetResults.Result.parseEntry(item.EntryID); tweet != nil {
+				if item.Item.ItemContent.TweetDisplayType == "SelfThread" {
+					tweet.IsSelfThread = true
 				}
+				tweets = append(tweets, tweet)
 			}
 
 			if item.Item.ItemContent.CursorType != "" && item.Item.ItemContent.Value != "" {
@@ -466,3 +547,11 @@ type tweetResult struct {
 func (tweetResult *tweetResult) parse() *Tweet {
 	return tweetResult.Data.TweetResult.Result.parse()
 }
+
+type tweetResults struct {
+	Data struct {
+		TweetResult []struct {
+			Result result `json:"result"`
+		} `json:"tweetResult"`
+	} `json:"data"`
+}
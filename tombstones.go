@@ -0,0 +1,104 @@
+package twitterscraper
+
+import "strings"
+
+// UnavailableReason explains why tweet content is not returned by twitter.
+type UnavailableReason string
+
+const (
+	// UnavailableDeleted - tweet or its author account was deleted
+	UnavailableDeleted UnavailableReason = "Deleted"
+	// UnavailableProtected - author limits who can view their tweets
+	UnavailableProtected UnavailableReason = "Protected"
+	// UnavailableSuspended - author account is suspended
+	UnavailableSuspended UnavailableReason = "Suspended"
+	// UnavailableAgeRestricted - adult content hidden for logged out or underage users
+	UnavailableAgeRestricted UnavailableReason = "AgeRestricted"
+	// UnavailableViolation - tweet violated the rules
+	UnavailableViolation UnavailableReason = "Violation"
+	// UnavailableWithheld - tweet is withheld in viewer country
+	UnavailableWithheld UnavailableReason = "Withheld"
+	// UnavailableUnknown - any other reason
+	UnavailableUnknown UnavailableReason = "Unknown"
+)
+
+type tombstone struct {
+	Text struct {
+		Text string `json:"text"`
+	} `json:"text"`
+}
+
+// parseEntry returns tweet of timeline entry, deleted and hidden tweets are
+// returned with Unavailable reason and ID taken from entry ID.
+func (result *result) parseEntry(entryID string) *Tweet {
+	switch result.Typename {
+	case "Tweet", "TweetWithVisibilityResults":
+		return result.parse()
+	case "TweetTombstone", "TweetUnavailable":
+		id := tweetIDFromEntryID(entryID)
+		if id == "" {
+			return nil
+		}
+		text := strings.TrimSpace(strings.TrimSuffix(result.Tombstone.Text.Text, "Learn more"))
+		reason := parseUnavailableReason(result.Reason)
+		if reason == UnavailableUnknown {
+			reason = parseTombstoneText(text)
+		}
+		return &Tweet{
+			ID:           id,
+			PermanentURL: "https://x.com/i/status/" + id,
+			Text:         text,
+			Unavailable:  reason,
+		}
+	}
+	return nil
+}
+
+// tweetIDFromEntryID extracts tweet ID from entry IDs like
+// "tweet-123" or "conversationthread-123-tweet-456".
+func tweetIDFromEntryID(entryID string) string {
+	i := strings.LastIndex(entryID, "tweet-")
+	if i < 0 {
+		return ""
+	}
+	id := entryID[i+len("tweet-"):]
+	if id == "" || strings.Trim(id, "0123456789") != "" {
+		return ""
+	}
+	return id
+}
+
+func parseUnavailableReason(reason string) UnavailableReason {
+	switch reason {
+	case "Protected":
+		return UnavailableProtected
+	case "Suspended":
+		return UnavailableSuspended
+	case "NsfwLoggedOut", "NsfwViewerIsUnderage", "NsfwViewerHasNoStatedAge":
+		return UnavailableAgeRestricted
+	case "Deleted":
+		return UnavailableDeleted
+	case "Withheld":
+		return UnavailableWithheld
+	}
+	return UnavailableUnknown
+}
+
+func parseTombstoneText(text string) UnavailableReason {
+	text = strings.ToLower(text)
+	switch {
+	case strings.Contains(text, "deleted"), strings.Contains(text, "no longer exists"):
+		return UnavailableDeleted
+	case strings.Contains(text, "suspended"):
+		return UnavailableSuspended
+	case strings.Contains(text, "limits who can view"), strings.Contains(text, "protected"):
+		return UnavailableProtected
+	case strings.Contains(text, "age-restricted"), strings.Contains(text, "adult content"):
+		return UnavailableAgeRestricted
+	case strings.Contains(text, "violated"):
+		return UnavailableViolation
+	case strings.Contains(text, "withheld"):
+		return UnavailableWithheld
+	}
+	return UnavailableUnknown
+}
@@ -2,6 +2,7 @@ package twitterscraper
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -37,6 +38,9 @@ func (newTweet *newTweet) parse() *Tweet {
 	if tw == nil {
 		return nil
 	}
+	if tw.Author != nil {
+		tw.Author.IsBlueVerified = tweet.Core.UserResults.Result.IsBlueVerified
+	}
 	if tw.Views == 0 && tweet.Views.Count != "" {
 		tw.Views, _ = strconv.Atoi(tweet.Views.Count)
 	}
@@ -339,6 +343,193 @@ func (s *Scraper) UnlikeTweet(tweetId string) error {
 
 	return nil
 }
+
+// NewList contains editable fields of a list.
+type NewList struct {
+	Name        string
+	Description string
+	IsPrivate   bool
+}
+
+type listMutation struct {
+	Data struct {
+		List            listResult `json:"list"`
+		ListSubscribeV3 listResult `json:"list_subscribe_v3"`
+		ListDelete      string     `json:"list_delete"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	} `json:"errors"`
+}
+
+// CreateList creates a new list owned by the logged in user.
+func (s *Scraper) CreateList(list NewList) (*List, error) {
+	response, err := s.mutateList("EYg7JZU3A1eJ-wr2eygPHQ", "CreateList", map[string]interface{}{
+		"name":        list.Name,
+		"description": list.Description,
+		"isPrivate":   list.IsPrivate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.List.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("list wasn't created")
+}
+
+// UpdateList changes name, description and privacy of a list.
+func (s *Scraper) UpdateList(listID string, list NewList) (*List, error) {
+	response, err := s.mutateList("dIEI1sbSAuZlxhE0ggrezA", "UpdateList", map[string]interface{}{
+		"listId":      listID,
+		"name":        list.Name,
+		"description": list.Description,
+		"isPrivate":   list.IsPrivate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.List.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("list wasn't updated")
+}
+
+// DeleteList deletes a list owned by the logged in user.
+func (s *Scraper) DeleteList(listID string) error {
+	response, err := s.mutateList("UnN9Th1BDbeLjpgjGSpL3Q", "DeleteList", map[string]interface{}{
+		"listId": listID,
+	})
+	if err != nil {
+		return err
+	}
+	if response.Data.ListDelete != "Done" {
+		return errors.New("unknown error")
+	}
+	return nil
+}
+
+// AddListMember adds user to a list.
+func (s *Scraper) AddListMember(listID string, userID string) (*List, error) {
+	response, err := s.mutateList("EadD8ivrhZhYQr2pDmCpjA", "ListAddMember", map[string]interface{}{
+		"listId": listID,
+		"userId": userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.List.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("member wasn't added")
+}
+
+// RemoveListMember removes user from a list.
+func (s *Scraper) RemoveListMember(listID string, userID string) (*List, error) {
+	response, err := s.mutateList("B5tMzrMYuFHJex_4EXFTSw", "ListRemoveMember", map[string]interface{}{
+		"listId": listID,
+		"userId": userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.List.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("member wasn't removed")
+}
+
+// SubscribeList subscribes the logged in user to a list.
+func (s *Scraper) SubscribeList(listID string) (*List, error) {
+	response, err := s.mutateList("FjvrQI3k-97JIUbEE6Gxcw", "ListSubscribe", map[string]interface{}{
+		"listId": listID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.ListSubscribeV3.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("list wasn't subscribed")
+}
+
+// UnsubscribeList unsubscribes the logged in user from a list.
+func (s *Scraper) UnsubscribeList(listID string) (*List, error) {
+	response, err := s.mutateList("hQ8TF_3fHw5Hoh8UNyVaDQ", "ListUnsubscribe", map[string]interface{}{
+		"listId": listID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result := response.Data.List.parse(); result != nil {
+		return result, nil
+	}
+	return nil, errors.New("list wasn't unsubscribed")
+}
+
+// PinList pins a list to the home timeline tabs of the logged in user.
+func (s *Scraper) PinList(listID string) (*List, error) {
+	if _, err := s.mutateList("2pYlo-kjdXoNOZJoLzI6KA", "ListPinOne", map[string]interface{}{
+		"listId": listID,
+	}); err != nil {
+		return nil, err
+	}
+	return s.GetList(listID)
+}
+
+// UnpinList unpins a list from the home timeline tabs of the logged in user.
+func (s *Scraper) UnpinList(listID string) (*List, error) {
+	if _, err := s.mutateList("c4ce-hzx6V4heV5IzdeBkA", "ListUnpinOne", map[string]interface{}{
+		"listId": listID,
+	}); err != nil {
+		return nil, err
+	}
+	return s.GetList(listID)
+}
+
+func (s *Scraper) mutateList(queryID string, operation string, variables map[string]interface{}) (*listMutation, error) {
+	if !s.isLogged {
+		return nil, errors.New("scraper is not logged in")
+	}
+
+	req, err := s.newRequest("POST", "https://x.com/i/api/graphql/"+queryID+"/"+operation)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	features := map[string]interface{}{
+		"rweb_tipjar_consumption_enabled":                                   true,
+		"responsive_web_graphql_exclude_directive_enabled":                  true,
+		"verified_phone_label_enabled":                                      false,
+		"responsive_web_graphql_skip_user_profile_image_extensions_enabled": false,
+		"responsive_web_graphql_timeline_navigation_enabled":                true,
+	}
+
+	body := map[string]interface{}{
+		"features":  features,
+		"variables": variables,
+		"queryId":   queryID,
+	}
+
+	b, _ := json.Marshal(body)
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	var response listMutation
+	err = s.RequestAPI(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Errors) > 0 {
+		return nil, errors.New(response.Errors[0].Message)
+	}
+
+	return &response, nil
+}
+
 func (s *Scraper) GetTweetRetweeters(tweetId string, maxUsersNbr int, cursor string) ([]*Profile, string, error) {
 	if maxUsersNbr > 200 {
 		maxUsersNbr = 200
@@ -390,7 +581,79 @@ func (s *Scraper) GetTweetRetweeters(tweetId string, maxUsersNbr int, cursor str
 	query.Set("features", mapToJSONString(features))
 	req.URL.RawQuery = query.Encode()
 
-	var timeline retweetersTimelineV2
+	var timeline userListTimelineV2
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, nextCursor := timeline.parseUsers()
+
+	if strings.HasPrefix(nextCursor, "0|") {
+		nextCursor = ""
+	}
+
+	return users, nextCursor, nil
+}
+
+// GetTweetLikers returns channel with users who liked the tweet.
+func (s *Scraper) GetTweetLikers(ctx context.Context, tweetId string, maxUsersNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, tweetId, maxUsersNbr, s.FetchTweetLikers)
+}
+
+// FetchTweetLikers gets users who liked the tweet, via the Twitter frontend API.
+func (s *Scraper) FetchTweetLikers(tweetId string, maxUsersNbr int, cursor string) ([]*Profile, string, error) {
+	if maxUsersNbr > 200 {
+		maxUsersNbr = 200
+	}
+
+	req, err := s.newRequest("GET", "https://x.com/i/api/graphql/LLkw5EcVutJL6y-2gkz22A/Favoriters")
+	if err != nil {
+		return nil, "", err
+	}
+
+	variables := map[string]interface{}{
+		"tweetId":                tweetId,
+		"includePromotedContent": false,
+		"count":                  maxUsersNbr,
+	}
+
+	features := map[string]interface{}{
+		"rweb_tipjar_consumption_enabled":                                         true,
+		"responsive_web_graphql_exclude_directive_enabled":                        true,
+		"verified_phone_label_enabled":                                            false,
+		"creator_subscriptions_tweet_preview_api_enabled":                         true,
+		"responsive_web_graphql_timeline_navigation_enabled":                      true,
+		"responsive_web_graphql_skip_user_profile_image_extensions_enabled":       false,
+		"communities_web_enable_tweet_community_results_fetch":                    true,
+		"c9s_tweet_anatomy_moderator_badge_enabled":                               true,
+		"articles_preview_enabled":                                                true,
+		"responsive_web_edit_tweet_api_enabled":                                   true,
+		"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+		"view_counts_everywhere_api_enabled":                                      true,
+		"longform_notetweets_consumption_enabled":                                 true,
+		"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+		"tweet_awards_web_tipping_enabled":                                        false,
+		"creator_subscriptions_quote_tweet_preview_enabled":                       false,
+		"freedom_of_speech_not_reach_fetch_enabled":                               true,
+		"standardized_nudges_misinfo":                                             true,
+		"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": true,
+		"rweb_video_timestamps_enabled":                                           true,
+		"longform_notetweets_rich_text_read_enabled":                              true,
+		"longform_notetweets_inline_media_enabled":                                true,
+		"responsive_web_enhance_cards_enabled":                                    false,
+	}
+
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+
+	query := url.Values{}
+	query.Set("variables", mapToJSONString(variables))
+	query.Set("features", mapToJSONString(features))
+	req.URL.RawQuery = query.Encode()
+
+	var timeline userListTimelineV2
 	err = s.RequestAPI(req, &timeline)
 	if err != nil {
 		return nil, "", err
@@ -124,3 +124,66 @@ func TestGetTweetRetweeters(t *testing.T) {
 		t.Error("0 tweet retweeters")
 	}
 }
+
+func TestFetchTweetLikers(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+	tweetId := "1792634158977568997"
+
+	likers, _, err := testScraper.FetchTweetLikers(tweetId, 20, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, liker := range likers {
+		if liker.UserID == "" {
+			t.Error("Expected liker UserID is empty")
+		}
+	}
+}
+
+func TestManageList(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	list, err := testScraper.CreateList(twitterscraper.NewList{Name: "scraper test", IsPrivate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	listID := list.ID
+	defer func() {
+		if err := testScraper.DeleteList(listID); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if list.Name != "scraper test" || list.Mode != "Private" {
+		t.Errorf("Expected private list 'scraper test', got %s %q", list.Mode, list.Name)
+	}
+
+	list, err = testScraper.UpdateList(listID, twitterscraper.NewList{Name: "scraper test", Description: "updated", IsPrivate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Description != "updated" {
+		t.Errorf("Expected description 'updated', got %q", list.Description)
+	}
+
+	list, err = testScraper.AddListMember(listID, "783214")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.MemberCount != 1 {
+		t.Errorf("Expected 1 member, got %d", list.MemberCount)
+	}
+
+	list, err = testScraper.RemoveListMember(listID, "783214")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.MemberCount != 0 {
+		t.Errorf("Expected 0 members, got %d", list.MemberCount)
+	}
+}
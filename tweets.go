@@ -252,11 +252,19 @@ func (s *Scraper) GetTweet(id string) (*Tweet, error) {
 			"longform_notetweets_rich_text_read_enabled":                              true,
 			"longform_notetweets_inline_media_enabled":                                true,
 			"responsive_web_enhance_cards_enabled":                                    false,
+			"articles_preview_enabled":                                                true,
+			"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+		}
+
+		fieldToggles := map[string]interface{}{
+			"withArticleRichContentState": true,
+			"withArticlePlainText":        true,
 		}
 
 		query := url.Values{}
 		query.Set("variables", mapToJSONString(variables))
 		query.Set("features", mapToJSONString(features))
+		query.Set("fieldToggles", mapToJSONString(fieldToggles))
 		req.URL.RawQuery = query.Encode()
 
 		var conversation threadedConversation
@@ -281,7 +289,7 @@ func (s *Scraper) GetTweet(id string) (*Tweet, error) {
 
 		tweets, _ := conversation.parse(id)
 		for _, tweet := range tweets {
-			if tweet.ID == id {
+			if tweet.ID == id && tweet.Unavailable == "" {
 				return tweet, nil
 			}
 		}
@@ -320,7 +328,10 @@ func (s *Scraper) GetTweet(id string) (*Tweet, error) {
 			"responsive_web_enhance_cards_enabled":                                    false,
 		}
 
-		fieldToggles := map[string]interface{}{"withArticleRichContentState": true}
+		fieldToggles := map[string]interface{}{
+			"withArticleRichContentState": true,
+			"withArticlePlainText":        true,
+		}
 
 		query := url.Values{}
 		query.Set("variables", mapToJSONString(variables))
@@ -354,6 +365,134 @@ func (s *Scraper) GetTweet(id string) (*Tweet, error) {
 	return nil, fmt.Errorf("tweet with ID %s not found", id)
 }
 
+// GetTweetEditHistory returns all versions of an edited tweet, from the original to the latest one.
+func (s *Scraper) GetTweetEditHistory(id string) ([]*Tweet, error) {
+	tweet, err := s.GetTweet(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tweet.IsEdited {
+		return []*Tweet{tweet}, nil
+	}
+
+	var versions []*Tweet
+	for _, versionID := range tweet.EditHistoryIDs {
+		if versionID == tweet.ID {
+			versions = append(versions, tweet)
+			continue
+		}
+
+		version, err := s.GetTweet(versionID)
+		if err != nil {
+			return versions, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// tweets per TweetResultsByRestIds request
+const tweetsByIDsChunkSize = 100
+
+// GetTweetsByIDs gets multiple tweets by IDs in batches. Deleted and hidden tweets
+// are returned with Unavailable reason, UnavailableUnknown if it can't be matched.
+func (s *Scraper) GetTweetsByIDs(ctx context.Context, ids []string) (map[string]*Tweet, error) {
+	tweets := make(map[string]*Tweet, len(ids))
+	for start := 0; start < len(ids); start += tweetsByIDsChunkSize {
+		if err := ctx.Err(); err != nil {
+			return tweets, err
+		}
+
+		end := start + tweetsByIDsChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		req, err := s.newRequest("GET", "https://x.com/i/api/graphql/q94uRCEn65LZThakYcPT6g/TweetResultsByRestIds")
+		if err != nil {
+			return tweets, err
+		}
+
+		variables := map[string]interface{}{
+			"tweetIds":               chunk,
+			"withCommunity":          false,
+			"includePromotedContent": false,
+			"withVoice":              false,
+		}
+
+		features := map[string]interface{}{
+			"creator_subscriptions_tweet_preview_api_enabled":                         true,
+			"c9s_tweet_anatomy_moderator_badge_enabled":                               true,
+			"tweetypie_unmention_optimization_enabled":                                true,
+			"responsive_web_edit_tweet_api_enabled":                                   true,
+			"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+			"view_counts_everywhere_api_enabled":                                      true,
+			"longform_notetweets_consumption_enabled":                                 true,
+			"responsive_web_twitter_article_tweet_consumption_enabled":                true,
+			"tweet_awards_web_tipping_enabled":                                        false,
+			"freedom_of_speech_not_reach_fetch_enabled":                               true,
+			"standardized_nudges_misinfo":                                             true,
+			"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": true,
+			"rweb_video_timestamps_enabled":                                           true,
+			"longform_notetweets_rich_text_read_enabled":                              true,
+			"longform_notetweets_inline_media_enabled":                                true,
+			"responsive_web_graphql_exclude_directive_enabled":                        true,
+			"verified_phone_label_enabled":                                            false,
+			"responsive_web_graphql_skip_user_profile_image_extensions_enabled":       false,
+			"responsive_web_graphql_timeline_navigation_enabled":                      true,
+			"responsive_web_enhance_cards_enabled":                                    false,
+		}
+
+		fieldToggles := map[string]interface{}{
+			"withArticleRichContentState": true,
+			"withArticlePlainText":        true,
+		}
+
+		query := url.Values{}
+		query.Set("variables", mapToJSONString(variables))
+		query.Set("features", mapToJSONString(features))
+		query.Set("fieldToggles", mapToJSONString(fieldToggles))
+		req.URL.RawQuery = query.Encode()
+
+		var results tweetResults
+		err = s.RequestAPI(req, &results)
+		if err != nil {
+			return tweets, err
+		}
+
+		for _, tweetResult := range results.Data.TweetResult {
+			if tweetResult.Result.Typename == "TweetTombstone" || tweetResult.Result.Typename == "TweetUnavailable" {
+				continue
+			}
+			if tweet := tweetResult.Result.parse(); tweet != nil && tweet.ID != "" {
+				tweets[tweet.ID] = tweet
+			}
+		}
+
+		for i, id := range chunk {
+			if _, ok := tweets[id]; ok {
+				continue
+			}
+			// Tombstones don't include tweet ID, results are in the same order as requested
+			if len(results.Data.TweetResult) == len(chunk) {
+				if tweet := results.Data.TweetResult[i].Result.parseEntry("tweet-" + id); tweet != nil && tweet.Unavailable != "" {
+					tweets[id] = tweet
+					continue
+				}
+			}
+			tweets[id] = &Tweet{
+				ID:           id,
+				PermanentURL: "https://x.com/i/status/" + id,
+				Unavailable:  UnavailableUnknown,
+			}
+		}
+	}
+	return tweets, nil
+}
+
 type homeEntry struct {
 	EntryId   string `json:"entryId"`
 	SortIndex string `json:"sortIndex"`
@@ -2,6 +2,7 @@ package twitterscraper_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	twitterscraper "github.com/0x090909/twitter-go"
@@ -11,6 +12,7 @@ import (
 
 var cmpOptions = cmp.Options{
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Likes"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "LikedAt"),
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Replies"),
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Retweets"),
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Views"),
@@ -18,6 +20,17 @@ var cmpOptions = cmp.Options{
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "IsSelfThread"),
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Thread"),
 	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "TimeParsed"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Author"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Card"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "EditHistoryIDs"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "EditableUntil"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "EditsRemaining"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "Bookmarks", "Quotes", "Lang", "LimitedActions", "PossiblySensitive"),
+	cmpopts.IgnoreFields(twitterscraper.Tweet{}, "ReplyPolicy", "Source", "WithheldCopyright", "WithheldCountries"),
+
+	cmpopts.IgnoreFields(twitterscraper.Photo{}, "MediaKey", "Width", "Height", "AltText", "Availability"),
+	cmpopts.IgnoreFields(twitterscraper.Video{}, "MediaKey", "Width", "Height", "AltText", "AspectRatio", "Duration", "Variants", "Availability"),
+	cmpopts.IgnoreFields(twitterscraper.GIF{}, "MediaKey", "Width", "Height", "AltText", "AspectRatio", "Variants", "Availability"),
 }
 
 func TestGetTweets(t *testing.T) {
@@ -108,6 +121,50 @@ func TestGetTweetWithVideo(t *testing.T) {
 	assertGetTweet(t, &expectedTweet)
 }
 
+func TestTweetMetadata(t *testing.T) {
+	tweet, err := testScraper.GetTweet("1697304622749086011")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tweet.Lang == "" {
+		t.Error("Expected tweet Lang is empty")
+	}
+	if tweet.Source == "" || strings.Contains(tweet.Source, "<") {
+		t.Errorf("Expected tweet Source is client name, got %q", tweet.Source)
+	}
+}
+
+func TestTweetMediaMetadata(t *testing.T) {
+	tweet, err := testScraper.GetTweet("1697304622749086011")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tweet.Videos) != 1 {
+		t.Fatalf("Expected 1 video, got %d", len(tweet.Videos))
+	}
+	video := tweet.Videos[0]
+	if video.MediaKey == "" {
+		t.Error("Expected video MediaKey is empty")
+	}
+	if video.Width == 0 || video.Height == 0 {
+		t.Error("Expected video dimensions are greater than zero")
+	}
+	if video.AspectRatio != [2]int{1, 1} {
+		t.Errorf("Expected video AspectRatio 1:1, got %d:%d", video.AspectRatio[0], video.AspectRatio[1])
+	}
+	if video.Duration == 0 {
+		t.Error("Expected video Duration is greater than zero")
+	}
+	if len(video.Variants) < 2 {
+		t.Error("Expected multiple video variants")
+	}
+	for _, variant := range video.Variants {
+		if variant.ContentType == "video/mp4" && (variant.Width == 0 || variant.Height == 0) {
+			t.Errorf("Expected resolution for variant %s", variant.URL)
+		}
+	}
+}
+
 func TestGetTweetWithMultiplePhotos(t *testing.T) {
 	expectedTweet := twitterscraper.Tweet{
 		ConversationID: "1577677328968204291",
@@ -194,6 +251,153 @@ func TestTweetMentions(t *testing.T) {
 	}
 }
 
+func TestTweetAuthor(t *testing.T) {
+	tweet, err := testScraper.GetTweet("1237110897597976576")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tweet.Author == nil {
+		t.Fatal("Expected tweet Author is nil")
+	}
+	if tweet.Author.UserID != tweet.UserID {
+		t.Errorf("Expected Author UserID %s, got %s", tweet.UserID, tweet.Author.UserID)
+	}
+	if tweet.Author.Username != tweet.Username {
+		t.Errorf("Expected Author Username %s, got %s", tweet.Username, tweet.Author.Username)
+	}
+	if tweet.Author.Avatar == "" {
+		t.Error("Expected Author Avatar is empty")
+	}
+	if tweet.Author.FollowersCount == 0 {
+		t.Error("Expected Author FollowersCount is greater than zero")
+	}
+	if tweet.QuotedStatus == nil || tweet.QuotedStatus.Author == nil {
+		t.Error("Expected quoted tweet Author is nil")
+	}
+}
+
+func TestTweetPoll(t *testing.T) {
+	tweet, err := testScraper.GetTweet("1604617643973124097")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tweet.Poll == nil {
+		t.Fatal("Expected tweet Poll is nil")
+	}
+	if len(tweet.Poll.Choices) != 2 {
+		t.Errorf("Expected 2 poll choices, got %d", len(tweet.Poll.Choices))
+	}
+	if !tweet.Poll.CountsAreFinal {
+		t.Error("CountsAreFinal must be True")
+	}
+	if tweet.Poll.TotalVotes == 0 {
+		t.Error("Expected poll TotalVotes is greater than zero")
+	}
+	if tweet.Poll.EndTime.IsZero() {
+		t.Error("Expected poll EndTime is zero")
+	}
+}
+
+func TestTweetCard(t *testing.T) {
+	found := false
+	for tweet := range testScraper.GetTweets(context.Background(), "verge", 20) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.Card == nil {
+			continue
+		}
+		found = true
+		if tweet.Card.Type == "" {
+			t.Error("Expected card Type is empty")
+		}
+		if tweet.Card.URL == "" {
+			t.Error("Expected card URL is empty")
+		}
+		if tweet.Card.Title == "" {
+			t.Error("Expected card Title is empty")
+		}
+	}
+	if !found {
+		t.Error("Expected at least one tweet with card")
+	}
+}
+
+func TestGetTweetEditHistory(t *testing.T) {
+	tweetID := "1697304622749086011"
+	versions, err := testScraper.GetTweetEditHistory(tweetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 {
+		t.Fatalf("Expected 1 version of not edited tweet, got %d", len(versions))
+	}
+	if versions[0].IsEdited {
+		t.Error("IsEdited must be False")
+	}
+	if len(versions[0].EditHistoryIDs) != 1 || versions[0].EditHistoryIDs[0] != tweetID {
+		t.Errorf("Expected EditHistoryIDs [%s], got %v", tweetID, versions[0].EditHistoryIDs)
+	}
+}
+
+func TestGetTweetEditHistoryOfEditedTweet(t *testing.T) {
+	var edited *twitterscraper.Tweet
+	for tweet := range testScraper.GetTweets(context.Background(), "elonmusk", 100) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.IsEdited && len(tweet.EditHistoryIDs) > 1 {
+			edited = &tweet.Tweet
+			break
+		}
+	}
+	if edited == nil {
+		t.Skip("No edited tweets found")
+	}
+
+	// The original version keeps edit history in edit_control_initial
+	versions, err := testScraper.GetTweetEditHistory(edited.EditHistoryIDs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != len(edited.EditHistoryIDs) {
+		t.Fatalf("Expected %d versions, got %d", len(edited.EditHistoryIDs), len(versions))
+	}
+	for i, version := range versions {
+		if version.ID != edited.EditHistoryIDs[i] {
+			t.Errorf("Expected version %d is %s, got %s", i, edited.EditHistoryIDs[i], version.ID)
+		}
+	}
+	latest := versions[len(versions)-1]
+	if latest.ID != edited.ID {
+		t.Errorf("Expected latest version is %s, got %s", edited.ID, latest.ID)
+	}
+	if !latest.IsEdited {
+		t.Error("IsEdited must be True")
+	}
+}
+
+func TestTweetArticlesAndNotes(t *testing.T) {
+	for tweet := range testScraper.GetTweets(context.Background(), "elonmusk", 40) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.Article != nil {
+			if tweet.Article.ID == "" {
+				t.Error("Expected article ID is empty")
+			}
+			if tweet.Article.Title == "" {
+				t.Error("Expected article Title is empty")
+			}
+		}
+		for _, tag := range tweet.RichTextTags {
+			if tag.FromIndex > tag.ToIndex || len(tag.Types) == 0 {
+				t.Errorf("Invalid rich text tag %+v in tweet %s", tag, tweet.ID)
+			}
+		}
+	}
+}
+
 func TestQuotedAndReply(t *testing.T) {
 	sample := &twitterscraper.Tweet{
 		ConversationID: "1237110546383724547",
@@ -467,3 +671,24 @@ func TestGetTweetsAndReplies(t *testing.T) {
 		t.Errorf("Got %d tweets", len(tweets))
 	}
 }
+
+func TestGetTweetsByIDs(t *testing.T) {
+	ids := []string{"1328684389388185600", "1665602315745673217", "1"}
+
+	tweets, err := testScraper.GetTweetsByIDs(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tweets) != len(ids) {
+		t.Fatalf("Expected %d tweets, got %d", len(ids), len(tweets))
+	}
+	for _, id := range ids[:2] {
+		if tweet := tweets[id]; tweet.ID != id || tweet.Unavailable != "" {
+			t.Errorf("Expected tweet %s is available", id)
+		}
+	}
+	if tweets["1"].Unavailable == "" {
+		t.Error("Expected tweet 1 is unavailable")
+	}
+}
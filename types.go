@@ -18,30 +18,115 @@ type (
 		Indices     []int  `json:"indices"`
 	}
 
+	// VideoVariant type.
+	VideoVariant struct {
+		URL         string
+		ContentType string
+		Bitrate     int
+		Width       int
+		Height      int
+	}
+
 	// Photo type.
 	Photo struct {
-		ID  string
-		URL string
+		ID           string
+		URL          string
+		MediaKey     string
+		Width        int
+		Height       int
+		AltText      string
+		Availability string
 	}
 
 	// Video type.
 	Video struct {
-		ID      string
-		Preview string
-		URL     string
-		HLSURL  string
+		ID           string
+		Preview      string
+		URL          string
+		HLSURL       string
+		MediaKey     string
+		Width        int
+		Height       int
+		AltText      string
+		AspectRatio  [2]int
+		Duration     time.Duration
+		Variants     []VideoVariant
+		Availability string
 	}
 
 	// GIF type.
 	GIF struct {
-		ID      string
-		Preview string
-		URL     string
+		ID           string
+		Preview      string
+		URL          string
+		MediaKey     string
+		Width        int
+		Height       int
+		AltText      string
+		AspectRatio  [2]int
+		Variants     []VideoVariant
+		Availability string
+	}
+
+	// PollChoice type.
+	PollChoice struct {
+		Label string
+		Count int
+	}
+
+	// Poll type.
+	Poll struct {
+		Choices         []PollChoice
+		TotalVotes      int
+		EndTime         time.Time
+		LastUpdated     time.Time
+		DurationMinutes int
+		CountsAreFinal  bool
+	}
+
+	// CardImage type.
+	CardImage struct {
+		URL    string
+		Width  int
+		Height int
+		Alt    string
+	}
+
+	// Card type.
+	Card struct {
+		Type         string
+		Title        string
+		Description  string
+		Domain       string
+		URL          string
+		Image        *CardImage
+		PlayerURL    string
+		PlayerWidth  int
+		PlayerHeight int
+	}
+
+	// RichTextTag is a formatted range of note tweet text, like Bold or Italic.
+	RichTextTag struct {
+		FromIndex int
+		ToIndex   int
+		Types     []string
+	}
+
+	// InlineMedia is a media placed inside of note tweet text.
+	InlineMedia struct {
+		MediaID string
+		Index   int
 	}
 
 	// Tweet type.
 	Tweet struct {
+		Article           *Article
+		Card              *Card
+		CommunityNote     *CommunityNote
 		ConversationID    string
+		EditableUntil     time.Time
+		EditHistoryIDs    []string
+		EditsRemaining    int
 		GIFs              []GIF
 		Hashtags          []string
 		HTML              string
@@ -49,22 +134,31 @@ type (
 		InReplyToStatus   *Tweet
 		InReplyToStatusID string
 		IsQuoted          bool
+		InlineMedia       []InlineMedia
+		IsEdited          bool
 		IsPin             bool
 		IsReply           bool
 		IsRetweet         bool
 		IsSelfThread      bool
+		Lang              string
 		Likes             int
+		LikedAt           time.Time
+		LimitedActions    []string
 		Name              string
 		Mentions          []Mention
 		PermanentURL      string
 		Photos            []Photo
 		Place             *Place
+		Poll              *Poll
 		QuotedStatus      *Tweet
 		QuotedStatusID    string
+		Quotes            int
 		Replies           int
+		ReplyPolicy       string
 		Retweets          int
 		RetweetedStatus   *Tweet
 		RetweetedStatusID string
+		RichTextTags      []RichTextTag
 		Text              string
 		Thread            []*Tweet
 		TimeParsed        time.Time
@@ -75,6 +169,13 @@ type (
 		Videos            []Video
 		Views             int
 		SensitiveContent  bool
+		PossiblySensitive bool
+		Source            string
+		Bookmarks         int
+		WithheldCopyright bool
+		WithheldCountries []string
+		Author            *Profile
+		Unavailable       UnavailableReason
 	}
 
 	// ProfileResult of scrapping.
@@ -89,6 +190,24 @@ type (
 		Error error
 	}
 
+	// ListResult of scrapping.
+	ListResult struct {
+		List
+		Error error
+	}
+
+	// NotificationResult of scrapping.
+	NotificationResult struct {
+		Notification
+		Error error
+	}
+
+	// DMEventResult of scrapping.
+	DMEventResult struct {
+		DMEvent
+		Error error
+	}
+
 	ScheduledTweet struct {
 		ID        string
 		State     string
@@ -101,16 +220,27 @@ type (
 
 	ExtendedMedia struct {
 		IDStr                    string `json:"id_str"`
+		MediaKey                 string `json:"media_key"`
 		MediaURLHttps            string `json:"media_url_https"`
+		ExtAltText               string `json:"ext_alt_text"`
 		ExtSensitiveMediaWarning struct {
 			AdultContent    bool `json:"adult_content"`
 			GraphicViolence bool `json:"graphic_violence"`
 			Other           bool `json:"other"`
 		} `json:"ext_sensitive_media_warning"`
+		ExtMediaAvailability struct {
+			Status string `json:"status"`
+		} `json:"ext_media_availability"`
+		OriginalInfo struct {
+			Width  int `json:"width"`
+			Height int `json:"height"`
+		} `json:"original_info"`
 		Type      string `json:"type"`
 		URL       string `json:"url"`
 		VideoInfo struct {
-			Variants []struct {
+			AspectRatio    []int `json:"aspect_ratio"`
+			DurationMillis int   `json:"duration_millis"`
+			Variants       []struct {
 				Type    string `json:"content_type"`
 				Bitrate int    `json:"bitrate"`
 				URL     string `json:"url"`
@@ -151,7 +281,8 @@ type (
 		RetweetedStatusResult struct {
 			Result *result `json:"result"`
 		} `json:"retweeted_status_result"`
-		QuotedStatusIDStr string `json:"quoted_status_id_str"`
+		Card              legacyCard `json:"card"`
+		QuotedStatusIDStr string     `json:"quoted_status_id_str"`
 		SelfThread        struct {
 			IDStr string `json:"id_str"`
 		} `json:"self_thread"`
@@ -161,6 +292,17 @@ type (
 			State string `json:"state"`
 			Count string `json:"count"`
 		} `json:"ext_views"`
+		Lang                string   `json:"lang"`
+		Source              string   `json:"source"`
+		QuoteCount          int      `json:"quote_count"`
+		BookmarkCount       int      `json:"bookmark_count"`
+		PossiblySensitive   bool     `json:"possibly_sensitive"`
+		LimitedActions      string   `json:"limited_actions"`
+		WithheldCopyright   bool     `json:"withheld_copyright"`
+		WithheldInCountries []string `json:"withheld_in_countries"`
+		ConversationControl struct {
+			Policy string `json:"policy"`
+		} `json:"conversation_control"`
 	}
 
 	legacyUser struct {
@@ -263,6 +405,9 @@ type (
 
 	fetchProfileFunc func(query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error)
 	fetchTweetFunc   func(query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error)
+	fetchListFunc    func(query string, maxListsNbr int, cursor string) ([]*List, string, error)
+
+	fetchNotificationFunc func(tab NotificationsTab, maxNotificationsNbr int, cursor string) ([]*Notification, string, error)
 
 	legacyExtendedProfile struct {
 		Birthdate struct {
@@ -570,10 +715,12 @@ type (
 	}
 
 	EditControl struct {
-		EditTweetIds       []string `json:"edit_tweet_ids"`
-		EditableUntilMsecs string   `json:"editable_until_msecs"`
-		IsEditEligible     bool     `json:"is_edit_eligible"`
-		EditsRemaining     string   `json:"edits_remaining"`
+		EditTweetIds       []string     `json:"edit_tweet_ids"`
+		EditableUntilMsecs string       `json:"editable_until_msecs"`
+		IsEditEligible     bool         `json:"is_edit_eligible"`
+		EditsRemaining     string       `json:"edits_remaining"`
+		InitialTweetID     string       `json:"initial_tweet_id,omitempty"`
+		EditControlInitial *EditControl `json:"edit_control_initial,omitempty"`
 	}
 
 	Views struct {
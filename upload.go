@@ -41,12 +41,21 @@ type uploadStatusResponse struct {
 
 // Uploads photo, video or gif for further posting or scheduling. Expires in 24 hours if not used.
 func (s *Scraper) UploadMedia(filePath string) (*Media, error) {
+	return s.uploadMedia(filePath, "tweet_")
+}
+
+// Uploads photo, video or gif for sending in direct message. Expires in 24 hours if not used.
+func (s *Scraper) UploadDMMedia(filePath string) (*Media, error) {
+	return s.uploadMedia(filePath, "dm_")
+}
+
+func (s *Scraper) uploadMedia(filePath string, categoryPrefix string) (*Media, error) {
 	fileContent, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
 
-	media, err := s.uploadInit(filePath, fileContent)
+	media, err := s.uploadInit(filePath, fileContent, categoryPrefix)
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +87,11 @@ func (s *Scraper) UploadMedia(filePath string) (*Media, error) {
 	return media, nil
 }
 
-func (s *Scraper) uploadInit(filePath string, fileContent []byte) (*Media, error) {
+func (s *Scraper) uploadInit(filePath string, fileContent []byte, categoryPrefix string) (*Media, error) {
 	var (
 		videoDuration float64
 		fileType      string
-		mediaCategory = "tweet_"
+		mediaCategory = categoryPrefix
 	)
 
 	fileType = http.DetectContentType(fileContent)
@@ -114,7 +123,7 @@ func (s *Scraper) uploadInit(filePath string, fileContent []byte) (*Media, error
 	query.Set("total_bytes", strconv.Itoa(len(fileContent)))
 	query.Set("media_type", fileType)
 	query.Set("media_category", mediaCategory)
-	if mediaCategory == "tweet_video" {
+	if mediaCategory == categoryPrefix+"video" {
 		query.Set("video_duration_ms", strconv.FormatFloat(videoDuration*1000, 'f', -1, 64))
 	}
 	req.URL.RawQuery = query.Encode()
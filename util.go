@@ -16,6 +16,8 @@ var (
 	reHashtag    = regexp.MustCompile(`\B(\#\S+\b)`)
 	reTwitterURL = regexp.MustCompile(`https:(\/\/t\.co\/([A-Za-z0-9]|[A-Za-z]){10})`)
 	reUsername   = regexp.MustCompile(`\B(\@\S{1,15}\b)`)
+	reResolution = regexp.MustCompile(`/(\d+)x(\d+)/`)
+	reHTMLTag    = regexp.MustCompile(`<[^>]*>`)
 	twURL        = urlParse("https://x.com")
 )
 
@@ -152,6 +154,96 @@ func getTweetTimeline(ctx context.Context, query string, maxTweetsNbr int, fetch
 	return channel
 }
 
+func getListTimeline(ctx context.Context, query string, maxListsNbr int, fetchFunc fetchListFunc) <-chan *ListResult {
+	channel := make(chan *ListResult)
+	go func(query string) {
+		defer close(channel)
+		var nextCursor string
+		listsNbr := 0
+		for listsNbr < maxListsNbr {
+			select {
+			case <-ctx.Done():
+				channel <- &ListResult{Error: ctx.Err()}
+				return
+			default:
+			}
+
+			lists, next, err := fetchFunc(query, maxListsNbr, nextCursor)
+			if err != nil {
+				channel <- &ListResult{Error: err}
+				return
+			}
+
+			if len(lists) == 0 {
+				break
+			}
+
+			for _, list := range lists {
+				select {
+				case <-ctx.Done():
+					channel <- &ListResult{Error: ctx.Err()}
+					return
+				default:
+				}
+
+				if listsNbr < maxListsNbr {
+					nextCursor = next
+					channel <- &ListResult{List: *list}
+				} else {
+					break
+				}
+				listsNbr++
+			}
+		}
+	}(query)
+	return channel
+}
+
+func getNotificationTimeline(ctx context.Context, tab NotificationsTab, maxNotificationsNbr int, fetchFunc fetchNotificationFunc) <-chan *NotificationResult {
+	channel := make(chan *NotificationResult)
+	go func(tab NotificationsTab) {
+		defer close(channel)
+		var nextCursor string
+		notificationsNbr := 0
+		for notificationsNbr < maxNotificationsNbr {
+			select {
+			case <-ctx.Done():
+				channel <- &NotificationResult{Error: ctx.Err()}
+				return
+			default:
+			}
+
+			notifications, next, err := fetchFunc(tab, maxNotificationsNbr, nextCursor)
+			if err != nil {
+				channel <- &NotificationResult{Error: err}
+				return
+			}
+
+			if len(notifications) == 0 {
+				break
+			}
+
+			for _, notification := range notifications {
+				select {
+				case <-ctx.Done():
+					channel <- &NotificationResult{Error: ctx.Err()}
+					return
+				default:
+				}
+
+				if notificationsNbr < maxNotificationsNbr {
+					nextCursor = next
+					channel <- &NotificationResult{Notification: *notification}
+				} else {
+					break
+				}
+				notificationsNbr++
+			}
+		}
+	}(tab)
+	return channel
+}
+
 func parseLegacyTweet(user *legacyUser, tweet *legacyTweet) *Tweet {
 	tweetID := tweet.IDStr
 	if tweetID == "" {
@@ -173,16 +265,29 @@ func parseLegacyTweet(user *legacyUser, tweet *legacyTweet) *Tweet {
 		Username:       username,
 	}
 
+	parseTweetMetadata(tw, tweet)
+
 	tm, err := time.Parse(time.RubyDate, tweet.CreatedAt)
 	if err == nil {
 		tw.TimeParsed = tm
 		tw.Timestamp = tm.Unix()
 	}
 
+	if user.ScreenName != "" {
+		author := parseProfile(*user)
+		if author.UserID == "" {
+			author.UserID = tweet.UserIDStr
+		}
+		tw.Author = &author
+	}
+
 	if tweet.Place.ID != "" {
 		tw.Place = &tweet.Place
 	}
 
+	tw.Poll = parsePoll(tweet.Card.Name, tweet.Card.BindingValues)
+	tw.Card = parseCard(tweet.Card.Name, tweet.Card.URL, tweet.Card.BindingValues, tweet.Entities.URLs)
+
 	if tweet.QuotedStatusIDStr != "" {
 		tw.IsQuoted = true
 		tw.QuotedStatusID = tweet.QuotedStatusIDStr
@@ -195,14 +300,9 @@ func parseLegacyTweet(user *legacyUser, tweet *legacyTweet) *Tweet {
 		tw.IsRetweet = true
 		tw.RetweetedStatusID = tweet.RetweetedStatusIDStr
 		if tweet.RetweetedStatusResult.Result != nil {
-			var legacy *legacyTweet = &tweet.RetweetedStatusResult.Result.Legacy
-			var user *legacyUser = &tweet.RetweetedStatusResult.Result.Core.UserResults.Result.Legacy
-			if tweet.RetweetedStatusResult.Result.Typename == "TweetWithVisibilityResults" {
-				legacy = &tweet.RetweetedStatusResult.Result.Tweet.Legacy
-				user = &tweet.RetweetedStatusResult.Result.Tweet.Core.UserResults.Result.Legacy
+			if tw.RetweetedStatus = tweet.RetweetedStatusResult.Result.parse(); tw.RetweetedStatus != nil {
+				tw.RetweetedStatusID = tw.RetweetedStatus.ID
 			}
-			tw.RetweetedStatus = parseLegacyTweet(user, legacy)
-			tw.RetweetedStatusID = tw.RetweetedStatus.ID
 		}
 	}
 
@@ -234,51 +334,13 @@ func parseLegacyTweet(user *legacyUser, tweet *legacyTweet) *Tweet {
 	}
 
 	for _, media := range tweet.ExtendedEntities.Media {
-		if media.Type == "photo" {
-			photo := Photo{
-				ID:  media.IDStr,
-				URL: media.MediaURLHttps,
-			}
-
-			tw.Photos = append(tw.Photos, photo)
-		} else if media.Type == "video" {
-			video := Video{
-				ID:      media.IDStr,
-				Preview: media.MediaURLHttps,
-			}
-
-			maxBitrate := 0
-			for _, variant := range media.VideoInfo.Variants {
-				if variant.Type == "application/x-mpegURL" {
-					video.HLSURL = variant.URL
-				}
-				if variant.Bitrate > maxBitrate {
-					video.URL = strings.TrimSuffix(variant.URL, "?tag=10")
-					maxBitrate = variant.Bitrate
-				}
-			}
-
-			tw.Videos = append(tw.Videos, video)
-		} else if media.Type == "animated_gif" {
-			gif := GIF{
-				ID:      media.IDStr,
-				Preview: media.MediaURLHttps,
-			}
-
-			// Twitter's API doesn't provide bitrate for GIFs, (it's always set to zero).
-			// Therefore we check for `>=` instead of `>` in the loop below.
-			// Also, GIFs have just a single variant today. Just in case that changes in the future,
-			// and there will be multiple variants, we'll pick the one with the highest bitrate,
-			// if other one will have a non-zero bitrate.
-			maxBitrate := 0
-			for _, variant := range media.VideoInfo.Variants {
-				if variant.Bitrate >= maxBitrate {
-					gif.URL = variant.URL
-					maxBitrate = variant.Bitrate
-				}
-			}
-
-			tw.GIFs = append(tw.GIFs, gif)
+		photo, video, gif := parseExtendedMedia(media)
+		if photo != nil {
+			tw.Photos = append(tw.Photos, *photo)
+		} else if video != nil {
+			tw.Videos = append(tw.Videos, *video)
+		} else if gif != nil {
+			tw.GIFs = append(tw.GIFs, *gif)
 		}
 
 		if !tw.SensitiveContent {
@@ -344,6 +406,45 @@ func parseLegacyTweet(user *legacyUser, tweet *legacyTweet) *Tweet {
 	return tw
 }
 
+func parseTweetMetadata(tw *Tweet, tweet *legacyTweet) {
+	tw.Lang = tweet.Lang
+	tw.Source = parseSource(tweet.Source)
+	tw.Quotes = tweet.QuoteCount
+	tw.Bookmarks = tweet.BookmarkCount
+	tw.PossiblySensitive = tweet.PossiblySensitive
+	tw.ReplyPolicy = tweet.ConversationControl.Policy
+	tw.WithheldCopyright = tweet.WithheldCopyright
+	tw.WithheldCountries = tweet.WithheldInCountries
+	if tweet.LimitedActions != "" {
+		tw.LimitedActions = []string{tweet.LimitedActions}
+	}
+}
+
+// parseSource returns client name from the source link, like `<a href="...">Twitter Web App</a>`
+func parseSource(source string) string {
+	return strings.TrimSpace(reHTMLTag.ReplaceAllString(source, ""))
+}
+
+func newVideoVariant(contentType string, bitrate int, url string) VideoVariant {
+	variant := VideoVariant{
+		URL:         url,
+		ContentType: contentType,
+		Bitrate:     bitrate,
+	}
+	if match := reResolution.FindStringSubmatch(url); match != nil {
+		variant.Width, _ = strconv.Atoi(match[1])
+		variant.Height, _ = strconv.Atoi(match[2])
+	}
+	return variant
+}
+
+func parseAspectRatio(ratio []int) [2]int {
+	if len(ratio) != 2 {
+		return [2]int{}
+	}
+	return [2]int{ratio[0], ratio[1]}
+}
+
 func parseProfile(user legacyUser) Profile {
 	profile := Profile{
 		Avatar:               user.ProfileImageURLHTTPS,
@@ -436,6 +537,16 @@ func parseProfileV2(user userResult) Profile {
 	return profile
 }
 
+// snowflakeTime returns creation time encoded in twitter snowflake ID.
+func snowflakeTime(id string) (time.Time, bool) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}, false
+	}
+	ms := n>>22 + 1288834974657
+	return time.Unix(0, ms*int64(time.Millisecond)), true
+}
+
 func mapToJSONString(data map[string]interface{}) string {
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
@@ -460,3 +571,74 @@ func urlParse(u string) *url.URL {
 	}
 	return parsed
 }
+
+// parseExtendedMedia converts media entity to photo, video or GIF, depending on its type.
+func parseExtendedMedia(media ExtendedMedia) (*Photo, *Video, *GIF) {
+	if media.Type == "photo" {
+		photo := Photo{
+			ID:           media.IDStr,
+			URL:          media.MediaURLHttps,
+			MediaKey:     media.MediaKey,
+			Width:        media.OriginalInfo.Width,
+			Height:       media.OriginalInfo.Height,
+			AltText:      media.ExtAltText,
+			Availability: media.ExtMediaAvailability.Status,
+		}
+
+		return &photo, nil, nil
+	} else if media.Type == "video" {
+		video := Video{
+			ID:           media.IDStr,
+			Preview:      media.MediaURLHttps,
+			MediaKey:     media.MediaKey,
+			Width:        media.OriginalInfo.Width,
+			Height:       media.OriginalInfo.Height,
+			AltText:      media.ExtAltText,
+			AspectRatio:  parseAspectRatio(media.VideoInfo.AspectRatio),
+			Duration:     time.Duration(media.VideoInfo.DurationMillis) * time.Millisecond,
+			Availability: media.ExtMediaAvailability.Status,
+		}
+
+		maxBitrate := 0
+		for _, variant := range media.VideoInfo.Variants {
+			video.Variants = append(video.Variants, newVideoVariant(variant.Type, variant.Bitrate, variant.URL))
+			if variant.Type == "application/x-mpegURL" {
+				video.HLSURL = variant.URL
+			}
+			if variant.Bitrate > maxBitrate {
+				video.URL = strings.TrimSuffix(variant.URL, "?tag=10")
+				maxBitrate = variant.Bitrate
+			}
+		}
+
+		return nil, &video, nil
+	} else if media.Type == "animated_gif" {
+		gif := GIF{
+			ID:           media.IDStr,
+			Preview:      media.MediaURLHttps,
+			MediaKey:     media.MediaKey,
+			Width:        media.OriginalInfo.Width,
+			Height:       media.OriginalInfo.Height,
+			AltText:      media.ExtAltText,
+			AspectRatio:  parseAspectRatio(media.VideoInfo.AspectRatio),
+			Availability: media.ExtMediaAvailability.Status,
+		}
+
+		// Twitter's API doesn't provide bitrate for GIFs, (it's always set to zero).
+		// Therefore we check for `>=` instead of `>` in the loop below.
+		// Also, GIFs have just a single variant today. Just in case that changes in the future,
+		// and there will be multiple variants, we'll pick the one with the highest bitrate,
+		// if other one will have a non-zero bitrate.
+		maxBitrate := 0
+		for _, variant := range media.VideoInfo.Variants {
+			gif.Variants = append(gif.Variants, newVideoVariant(variant.Type, variant.Bitrate, variant.URL))
+			if variant.Bitrate >= maxBitrate {
+				gif.URL = variant.URL
+				maxBitrate = variant.Bitrate
+			}
+		}
+
+		return nil, nil, &gif
+	}
+	return nil, nil, nil
+}
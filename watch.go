@@ -0,0 +1,166 @@
+package twitterscraper
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// maximum pages loaded in one poll to catch up with new tweets
+const watchCatchUpPages = 5
+
+// WatchUser returns channel with new tweets of user, polling every interval.
+// It starts from the latest tweet, errors are sent to channel and polling continues.
+func (s *Scraper) WatchUser(ctx context.Context, user string, interval time.Duration) <-chan *TweetResultTimeline {
+	return s.watchTweets(ctx, user, s.userTweetsEndpoint(user), interval, s.FetchTweets)
+}
+
+// userTweetsEndpoint returns rate limit key of endpoint used by FetchTweets for user.
+func (s *Scraper) userTweetsEndpoint(user string) func() string {
+	return func() string {
+		if !s.isOpenAccount {
+			return "UserTweets"
+		}
+		userID, err := s.GetUserIDByScreenName(user)
+		if err != nil {
+			return ""
+		}
+		return userID + ".json"
+	}
+}
+
+// WatchSearch returns channel with new tweets found by query in latest mode, polling every interval.
+// It starts from the latest tweet, errors are sent to channel and polling continues.
+func (s *Scraper) WatchSearch(ctx context.Context, query string, interval time.Duration) <-chan *TweetResultTimeline {
+	endpoint := func() string { return "SearchTimeline" }
+	return s.watchTweets(ctx, query, endpoint, interval, func(query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+		timeline, err := s.getSearchTimeline(query, SearchLatest, maxTweetsNbr, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		tweets, nextCursor := timeline.parseTweets()
+		return tweets, nextCursor, nil
+	})
+}
+
+func (s *Scraper) watchTweets(ctx context.Context, query string, endpoint func() string, interval time.Duration, fetchFunc fetchTweetFunc) <-chan *TweetResultTimeline {
+	channel := make(chan *TweetResultTimeline)
+	go func() {
+		defer close(channel)
+		w := &tweetsWatcher{seen: make(map[string]bool)}
+		for {
+			tweets, err := w.poll(query, fetchFunc)
+			if err != nil {
+				select {
+				case channel <- &TweetResultTimeline{Error: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			for _, tweet := range tweets {
+				select {
+				case channel <- &TweetResultTimeline{Tweet: *tweet}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(s.watchInterval(endpoint(), interval)):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+// watchInterval returns poll interval increased to not exhaust rate limit of endpoint before its reset.
+func (s *Scraper) watchInterval(endpoint string, interval time.Duration) time.Duration {
+	limit, ok := s.getRateLimit(endpoint)
+	if !ok {
+		return interval
+	}
+	untilReset := time.Until(limit.Reset)
+	if untilReset <= 0 {
+		return interval
+	}
+	if limit.Remaining <= 0 {
+		return untilReset
+	}
+	if d := untilReset / time.Duration(limit.Remaining); d > interval {
+		return d
+	}
+	return interval
+}
+
+// tweetsWatcher keeps state of polled timeline to emit every tweet only once.
+type tweetsWatcher struct {
+	started bool
+	newest  string          // newest tweet ID of timeline
+	oldest  string          // older tweets are not emitted and forgotten
+	seen    map[string]bool // tweets newer than oldest that were emitted or skipped
+}
+
+// poll returns new tweets ordered from the oldest. The first poll only remembers existing tweets.
+func (w *tweetsWatcher) poll(query string, fetchFunc fetchTweetFunc) ([]*Tweet, error) {
+	var fetched []*Tweet
+	var cursor string
+	for page := 0; page < watchCatchUpPages; page++ {
+		tweets, next, err := fetchFunc(query, 20, cursor)
+		if err != nil {
+			return nil, err
+		}
+		fetched = append(fetched, tweets...)
+
+		reachedNewest := !w.started
+		for _, tweet := range tweets {
+			if !tweet.IsPin && !sortIndexGreater(tweet.ID, w.newest) {
+				reachedNewest = true
+			}
+		}
+		if reachedNewest || next == "" || len(tweets) == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	var newTweets []*Tweet
+	oldest := ""
+	for _, tweet := range fetched {
+		if tweet.IsPin {
+			continue
+		}
+		if oldest == "" || sortIndexGreater(oldest, tweet.ID) {
+			oldest = tweet.ID
+		}
+		if w.newest == "" || sortIndexGreater(tweet.ID, w.newest) {
+			w.newest = tweet.ID
+		}
+	}
+	for _, tweet := range fetched {
+		// tombstone stubs are not new tweets
+		if tweet.Unavailable != "" || w.seen[tweet.ID] {
+			continue
+		}
+		w.seen[tweet.ID] = true
+		if w.started && (w.oldest == "" || sortIndexGreater(tweet.ID, w.oldest)) {
+			newTweets = append(newTweets, tweet)
+		}
+	}
+
+	if oldest != "" && (w.oldest == "" || sortIndexGreater(oldest, w.oldest)) {
+		w.oldest = oldest
+		for id := range w.seen {
+			if sortIndexGreater(w.oldest, id) {
+				delete(w.seen, id)
+			}
+		}
+	}
+	w.started = true
+
+	sort.Slice(newTweets, func(i, j int) bool {
+		return sortIndexGreater(newTweets[j].ID, newTweets[i].ID)
+	})
+	return newTweets, nil
+}
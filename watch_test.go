@@ -0,0 +1,52 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWatchUser(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	seen := make(map[string]bool)
+	for tweet := range testScraper.WatchUser(ctx, "x", 3*time.Second) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if seen[tweet.ID] {
+			t.Errorf("Expected tweet %s is emitted once", tweet.ID)
+		}
+		seen[tweet.ID] = true
+	}
+}
+
+func TestWatchSearch(t *testing.T) {
+	if skipAuthTest {
+		t.Skip("Skipping test due to environment variable")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	seen := make(map[string]bool)
+	var last string
+	for tweet := range testScraper.WatchSearch(ctx, "twitter", 5*time.Second) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if seen[tweet.ID] {
+			t.Errorf("Expected tweet %s is emitted once", tweet.ID)
+		}
+		seen[tweet.ID] = true
+		if last != "" && len(tweet.ID) == len(last) && tweet.ID < last {
+			t.Errorf("Expected tweets ordered from the oldest, got %s after %s", tweet.ID, last)
+		}
+		last = tweet.ID
+	}
+}